@@ -0,0 +1,97 @@
+package main
+
+import (
+	"math"
+	"reflect"
+)
+
+// defaultAuditTolerance is the deviation, in percent, allowed between a
+// stored property and the value derived from geometry. Tabulated values are
+// rounded to three significant figures, which alone accounts for up to 0.5%.
+const defaultAuditTolerance = 1.0
+
+// geometryColumns are the dimensional fields properties are derived from;
+// they are the inputs of an audit rather than checked by it
+var geometryColumns = map[string]bool{
+	"section_designation": true,
+	"depth_of_section":    true,
+	"width_of_section":    true,
+	"thickness_web":       true,
+	"thickness_flange":    true,
+	"root_radius":         true,
+}
+
+// PropertyDeviation is a stored property that differs from its derived value
+type PropertyDeviation struct {
+	Field            string  `json:"field"`
+	Stored           float64 `json:"stored"`
+	Derived          float64 `json:"derived"`
+	DeviationPercent float64 `json:"deviation_percent"`
+}
+
+// BeamAudit lists the deviating properties of one beam. Error is set instead
+// when the beam's geometry is not enough to derive its properties.
+type BeamAudit struct {
+	SectionDesignation string              `json:"section_designation"`
+	Deviations         []PropertyDeviation `json:"deviations,omitempty"`
+	Error              string              `json:"error,omitempty"`
+}
+
+// AuditReport is the result of auditing a set of beams. Beams lists only
+// the beams with deviations or errors.
+type AuditReport struct {
+	Tolerance float64     `json:"tolerance_percent"`
+	Checked   int         `json:"checked"`
+	Flagged   int         `json:"flagged"`
+	Beams     []BeamAudit `json:"beams"`
+}
+
+// AuditBeam recomputes the properties of beam from its dimensions and
+// reports every stored, non-zero property deviating from the derived value
+// by more than tolerance percent. Properties left at zero are unknown and
+// not checked.
+func AuditBeam(beam SteelBeam, tolerance float64) BeamAudit {
+	audit := BeamAudit{SectionDesignation: beam.SectionDesignation}
+
+	derived, err := DeriveSectionProperties(beamGeometry(beam))
+	if err != nil {
+		audit.Error = "cannot derive properties: " + err.Error()
+		return audit
+	}
+
+	stored := reflect.ValueOf(beam)
+	source := reflect.ValueOf(derived)
+	for _, column := range beamColumns {
+		if geometryColumns[column.Name] {
+			continue
+		}
+		value := stored.Field(column.Index).Float()
+		expected := source.Field(column.Index).Float()
+		if value == 0 || expected == 0 {
+			continue
+		}
+		deviation := (value - expected) / expected * 100
+		if math.Abs(deviation) > tolerance {
+			audit.Deviations = append(audit.Deviations, PropertyDeviation{
+				Field:            column.Name,
+				Stored:           value,
+				Derived:          expected,
+				DeviationPercent: math.Round(deviation*100) / 100,
+			})
+		}
+	}
+	return audit
+}
+
+// AuditBeams audits every beam against tolerance percent
+func AuditBeams(beams []SteelBeam, tolerance float64) AuditReport {
+	report := AuditReport{Tolerance: tolerance, Checked: len(beams), Beams: []BeamAudit{}}
+	for _, beam := range beams {
+		audit := AuditBeam(beam, tolerance)
+		if len(audit.Deviations) > 0 || audit.Error != "" {
+			report.Beams = append(report.Beams, audit)
+		}
+	}
+	report.Flagged = len(report.Beams)
+	return report
+}
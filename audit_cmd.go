@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// runAuditCommand implements `formandfunction-api audit`, which checks the
+// catalogue selected by BEAM_STORE against properties derived from each
+// beam's dimensions. The store is only read; a file store that does not
+// exist yet is audited as the built-in tables it would be created from. It
+// returns the process exit code: 0 if every beam is within tolerance, 1 if
+// any was flagged and 2 if the audit could not run.
+func runAuditCommand(args []string) int {
+	flags := flag.NewFlagSet("audit", flag.ContinueOnError)
+	flags.SetOutput(os.Stderr)
+	tolerance := flags.Float64("tolerance", defaultAuditTolerance, "allowed deviation from the derived value, in percent")
+	asJSON := flags.Bool("json", false, "write the report as JSON")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: formandfunction-api audit [-tolerance percent] [-json]")
+		fmt.Fprintln(flags.Output(), "Reports stored beam properties that deviate from those derived from the section dimensions.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *tolerance < 0 {
+		fmt.Fprintln(os.Stderr, "audit: tolerance must not be negative")
+		return 2
+	}
+
+	seed, err := LoadDefaultBeams()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "audit: failed to load built-in section tables: %v\n", err)
+		return 2
+	}
+	beams, err := LoadBeamsFromEnv(seed)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "audit: failed to read beam repository: %v\n", err)
+		return 2
+	}
+
+	report := AuditBeams(beams, *tolerance)
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(os.Stderr, "audit: %v\n", err)
+			return 2
+		}
+	} else {
+		writeAuditReport(os.Stdout, report)
+	}
+
+	if report.Flagged > 0 {
+		return 1
+	}
+	return 0
+}
+
+// writeAuditReport prints a report as a table, one row per deviating property
+func writeAuditReport(w io.Writer, report AuditReport) {
+	fmt.Fprintf(w, "Checked %d beams against a tolerance of %g%%: %d flagged\n",
+		report.Checked, report.Tolerance, report.Flagged)
+	if report.Flagged == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SECTION\tFIELD\tSTORED\tDERIVED\tDEVIATION")
+	for _, audit := range report.Beams {
+		if audit.Error != "" {
+			fmt.Fprintf(tw, "%s\t-\t-\t-\t%s\n", audit.SectionDesignation, audit.Error)
+			continue
+		}
+		for _, d := range audit.Deviations {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%+.2f%%\n", audit.SectionDesignation, d.Field,
+				formatAuditValue(d.Stored), formatAuditValue(d.Derived), d.DeviationPercent)
+		}
+	}
+	tw.Flush()
+}
+
+// formatAuditValue prints v to four significant figures without an exponent
+func formatAuditValue(v float64) string {
+	if v == 0 {
+		return "0"
+	}
+	decimals := 3 - int(math.Floor(math.Log10(math.Abs(v))))
+	if decimals <= 0 {
+		scale := math.Pow(10, float64(-decimals))
+		return strconv.FormatFloat(math.Round(v/scale)*scale, 'f', 0, 64)
+	}
+	formatted := strconv.FormatFloat(v, 'f', decimals, 64)
+	return strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+}
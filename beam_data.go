@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// sectionTables holds the built-in section tables shipped with the binary
+//
+//go:embed data/*.csv data/sections/*.json
+var sectionTables embed.FS
+
+// defaultBeamTables lists the embedded files that seed the beam catalogue
+var defaultBeamTables = []string{
+	"data/ukb.csv", // Blue Book UKB universal beams
+}
+
+// beamColumn maps a SteelBeam JSON tag onto its struct field
+type beamColumn struct {
+	Name  string
+	Index int
+}
+
+// beamColumns lists every SteelBeam field by JSON tag, in struct order
+var beamColumns = steelBeamColumns()
+
+func steelBeamColumns() []beamColumn {
+	t := reflect.TypeOf(SteelBeam{})
+	columns := make([]beamColumn, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		columns = append(columns, beamColumn{Name: name, Index: i})
+	}
+	return columns
+}
+
+// beamColumnByName returns the column with the given JSON tag
+func beamColumnByName(name string) (beamColumn, bool) {
+	for _, column := range beamColumns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+	return beamColumn{}, false
+}
+
+// beamFieldValue returns the value of a numeric SteelBeam column
+func beamFieldValue(beam SteelBeam, column beamColumn) float64 {
+	return reflect.ValueOf(beam).Field(column.Index).Float()
+}
+
+// BeamRowError describes a single rejected row in a section table
+type BeamRowError struct {
+	Source             string
+	Line               int
+	SectionDesignation string
+	Err                error
+}
+
+func (e *BeamRowError) Error() string {
+	if e.SectionDesignation != "" {
+		return fmt.Sprintf("%s:%d (%s): %v", e.Source, e.Line, e.SectionDesignation, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.Source, e.Line, e.Err)
+}
+
+func (e *BeamRowError) Unwrap() error {
+	return e.Err
+}
+
+// LoadDefaultBeams parses the embedded section tables. Every malformed row
+// and duplicate designation is reported in the returned error; nothing is
+// skipped silently.
+func LoadDefaultBeams() ([]SteelBeam, error) {
+	var (
+		beams []SteelBeam
+		errs  []error
+		seen  = map[string]string{}
+	)
+	for _, name := range defaultBeamTables {
+		data, err := sectionTables.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read section table %s: %w", name, err)
+		}
+
+		parsed, lines, err := ParseBeamsCSV(name, bytes.NewReader(data))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for i, beam := range parsed {
+			location := fmt.Sprintf("%s:%d", name, lines[i])
+			if err := ValidateBeam(beam); err != nil {
+				errs = append(errs, &BeamRowError{
+					Source:             name,
+					Line:               lines[i],
+					SectionDesignation: beam.SectionDesignation,
+					Err:                err,
+				})
+				continue
+			}
+			if first, ok := seen[beam.SectionDesignation]; ok {
+				errs = append(errs, &BeamRowError{
+					Source:             name,
+					Line:               lines[i],
+					SectionDesignation: beam.SectionDesignation,
+					Err:                fmt.Errorf("duplicate section designation (first defined at %s)", first),
+				})
+				continue
+			}
+			seen[beam.SectionDesignation] = location
+			beams = append(beams, beam)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return beams, nil
+}
+
+// ParseBeamsCSV reads a section table whose header row names SteelBeam JSON
+// tags. It returns the beams from well-formed rows together with their line
+// numbers, and a joined error listing every row that could not be parsed.
+func ParseBeamsCSV(source string, r io.Reader) ([]SteelBeam, []int, error) {
+	var (
+		beams []SteelBeam
+		lines []int
+		errs  []error
+	)
+	err := ScanBeamsCSV(source, r, func(line int, beam SteelBeam, err error) {
+		if err != nil {
+			errs = append(errs, &BeamRowError{Source: source, Line: line, SectionDesignation: beam.SectionDesignation, Err: err})
+			return
+		}
+		beams = append(beams, beam)
+		lines = append(lines, line)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return beams, lines, errors.Join(errs...)
+}
+
+// ScanBeamsCSV reads a section table whose header row names SteelBeam JSON
+// tags, calling row for every record with its line number and either the
+// parsed beam or the reason it could not be parsed. It returns an error only
+// when the header itself is unusable or reading r fails.
+func ScanBeamsCSV(source string, r io.Reader, row func(line int, beam SteelBeam, err error)) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%s: failed to read header: %w", source, err)
+	}
+
+	columns := make([]beamColumn, len(header))
+	present := map[string]bool{}
+	for i, name := range header {
+		column, ok := beamColumnByName(strings.TrimSpace(name))
+		if !ok {
+			return fmt.Errorf("%s: unknown column %q", source, name)
+		}
+		if present[column.Name] {
+			return fmt.Errorf("%s: duplicate column %q", source, column.Name)
+		}
+		present[column.Name] = true
+		columns[i] = column
+	}
+	if !present["section_designation"] {
+		return fmt.Errorf("%s: missing section_designation column", source)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// The record is unusable, and FieldPos is only valid after a
+			// successful Read, so the line comes from the parse error
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return fmt.Errorf("%s: %w", source, err)
+			}
+			row(parseErr.StartLine, SteelBeam{}, parseErr.Err)
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		beam, err := parseBeamRecord(columns, record)
+		row(line, beam, err)
+	}
+}
+
+// parseBeamRecord converts one CSV record into a SteelBeam
+func parseBeamRecord(columns []beamColumn, record []string) (SteelBeam, error) {
+	var beam SteelBeam
+	v := reflect.ValueOf(&beam).Elem()
+
+	var problems []string
+	for i, column := range columns {
+		value := strings.TrimSpace(record[i])
+		field := v.Field(column.Index)
+		if field.Kind() == reflect.String {
+			field.SetString(value)
+			continue
+		}
+
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			problems = append(problems, fmt.Sprintf("%s: invalid number %q", column.Name, value))
+			continue
+		}
+		field.SetFloat(f)
+	}
+	if beam.SectionDesignation == "" {
+		problems = append(problems, "section_designation is empty")
+	}
+	if len(problems) > 0 {
+		return beam, errors.New(strings.Join(problems, "; "))
+	}
+	return beam, nil
+}
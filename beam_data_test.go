@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseBeamsCSVReportsMalformedRows(t *testing.T) {
+	input := strings.Join([]string{
+		"section_designation,mass_per_metre",
+		"UB1,10",
+		`"UB2"x,2`,
+		"UB3,30,extra",
+		"UB4,40",
+	}, "\n")
+
+	beams, lines, err := ParseBeamsCSV("test.csv", strings.NewReader(input))
+	if got := beamDesignations(beams); !slices.Equal(got, []string{"UB1", "UB4"}) {
+		t.Errorf("got beams %v, want [UB1 UB4]", got)
+	}
+	if !slices.Equal(lines, []int{2, 5}) {
+		t.Errorf("got lines %v, want [2 5]", lines)
+	}
+
+	want := []struct {
+		line int
+		err  error
+	}{
+		{3, csv.ErrQuote},
+		{4, csv.ErrFieldCount},
+	}
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		t.Fatalf("got error %v, want a joined row error", err)
+	}
+	rows := joined.Unwrap()
+	if len(rows) != len(want) {
+		t.Fatalf("got %d row errors, want %d: %v", len(rows), len(want), err)
+	}
+	for i, w := range want {
+		var rowErr *BeamRowError
+		if !errors.As(rows[i], &rowErr) {
+			t.Fatalf("row error %d: got %T, want *BeamRowError", i, rows[i])
+		}
+		if rowErr.Source != "test.csv" || rowErr.Line != w.line {
+			t.Errorf("row error %d: got %s:%d, want test.csv:%d", i, rowErr.Source, rowErr.Line, w.line)
+		}
+		if !errors.Is(rowErr, w.err) {
+			t.Errorf("row error %d: got %v, want %v", i, rowErr.Err, w.err)
+		}
+	}
+}
+
+func TestScanBeamsCSVReportsMalformedRows(t *testing.T) {
+	input := strings.Join([]string{
+		"section_designation,mass_per_metre",
+		"UB1,10",
+		`"UB2"x,2`,
+		"UB3,30,extra",
+		"UB4,40",
+	}, "\n")
+
+	type scanned struct {
+		line        int
+		designation string
+		err         error
+	}
+	var rows []scanned
+	err := ScanBeamsCSV("test.csv", strings.NewReader(input), func(line int, beam SteelBeam, err error) {
+		rows = append(rows, scanned{line, beam.SectionDesignation, err})
+	})
+	if err != nil {
+		t.Fatalf("ScanBeamsCSV returned %v", err)
+	}
+
+	want := []struct {
+		line        int
+		designation string
+		err         error
+	}{
+		{2, "UB1", nil},
+		{3, "", csv.ErrQuote},
+		{4, "", csv.ErrFieldCount},
+		{5, "UB4", nil},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(rows), len(want), rows)
+	}
+	for i, w := range want {
+		got := rows[i]
+		if got.line != w.line || got.designation != w.designation {
+			t.Errorf("row %d: got line %d %q, want line %d %q", i, got.line, got.designation, w.line, w.designation)
+		}
+		if w.err == nil && got.err != nil {
+			t.Errorf("row %d: unexpected error %v", i, got.err)
+		}
+		if w.err != nil && !errors.Is(got.err, w.err) {
+			t.Errorf("row %d: got error %v, want %v", i, got.err, w.err)
+		}
+	}
+}
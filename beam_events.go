@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultBeamEventLogSize is how many recent events are kept for resumption
+const defaultBeamEventLogSize = 1024
+
+// beamEventBuffer is how many events a subscriber may fall behind before it is dropped
+const beamEventBuffer = 64
+
+// ErrResumeTokenExpired is returned when a resume token refers to events no
+// longer held in the log, or to a previous run of the server. The client
+// should reload the catalogue and watch from the present.
+var ErrResumeTokenExpired = errors.New("resume token has expired; reload the catalogue and watch again")
+
+// ErrInvalidResumeToken is returned for a token this server did not issue
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// BeamEventType is the kind of change made to the catalogue
+type BeamEventType string
+
+const (
+	BeamCreated BeamEventType = "created"
+	BeamUpdated BeamEventType = "updated"
+	BeamDeleted BeamEventType = "deleted"
+)
+
+// BeamEvent records one change to the catalogue. Beam is the beam as
+// stored after a create or update and is nil for a delete.
+type BeamEvent struct {
+	ID                 uint64        `json:"id"`
+	Type               BeamEventType `json:"type"`
+	SectionDesignation string        `json:"section_designation"`
+	Beam               *SteelBeam    `json:"beam,omitempty"`
+	OccurredAt         time.Time     `json:"occurred_at"`
+}
+
+// BeamEventLog keeps a bounded history of catalogue changes and fans new
+// events out to subscribers. IDs increase by one per event and restart with
+// the process, so resume tokens also carry the epoch the log was created in.
+type BeamEventLog struct {
+	mu          sync.Mutex
+	epoch       string
+	capacity    int
+	events      []BeamEvent
+	nextID      uint64
+	subscribers map[chan BeamEvent]struct{}
+}
+
+// NewBeamEventLog returns an empty log retaining up to capacity events
+func NewBeamEventLog(capacity int) *BeamEventLog {
+	return &BeamEventLog{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		capacity:    capacity,
+		nextID:      1,
+		subscribers: map[chan BeamEvent]struct{}{},
+	}
+}
+
+// Publish assigns the next ID to event, records it and delivers it to every
+// subscriber. A subscriber whose buffer is full is dropped by closing its
+// channel rather than blocking the writer.
+func (l *BeamEventLog) Publish(event BeamEvent) BeamEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	event.ID = l.nextID
+	l.nextID++
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+	l.events = append(l.events, event)
+	if len(l.events) > l.capacity {
+		l.events = append([]BeamEvent(nil), l.events[len(l.events)-l.capacity:]...)
+	}
+
+	for ch := range l.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(l.subscribers, ch)
+			close(ch)
+		}
+	}
+	return event
+}
+
+// Subscribe returns the retained events after the given ID followed by a
+// channel of new events. An after of zero skips the history. The channel is
+// closed if the subscriber falls more than beamEventBuffer events behind;
+// cancel must be called once the subscriber stops reading.
+func (l *BeamEventLog) Subscribe(after uint64) (backlog []BeamEvent, events <-chan BeamEvent, cancel func(), err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if after > 0 {
+		if after >= l.nextID {
+			return nil, nil, nil, ErrInvalidResumeToken
+		}
+		oldest := l.nextID
+		if len(l.events) > 0 {
+			oldest = l.events[0].ID
+		}
+		if after+1 < oldest {
+			return nil, nil, nil, ErrResumeTokenExpired
+		}
+		for _, event := range l.events {
+			if event.ID > after {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	ch := make(chan BeamEvent, beamEventBuffer)
+	l.subscribers[ch] = struct{}{}
+	cancel = func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.subscribers[ch]; ok {
+			delete(l.subscribers, ch)
+			close(ch)
+		}
+	}
+	return backlog, ch, cancel, nil
+}
+
+// ResumeToken returns the opaque token identifying event in this log
+func (l *BeamEventLog) ResumeToken(event BeamEvent) string {
+	return l.epoch + "-" + strconv.FormatUint(event.ID, 10)
+}
+
+// ParseResumeToken returns the event ID a token refers to. An empty token
+// yields zero. A token from a previous run of the server is expired.
+func (l *BeamEventLog) ParseResumeToken(token string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	epoch, id, ok := strings.Cut(token, "-")
+	if !ok {
+		return 0, ErrInvalidResumeToken
+	}
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || n == 0 {
+		return 0, ErrInvalidResumeToken
+	}
+	if epoch != l.epoch {
+		return 0, fmt.Errorf("%w (issued by a previous server run)", ErrResumeTokenExpired)
+	}
+	return n, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sseKeepAlive is how often an idle event stream sends a comment, so
+// proxies keep the connection open and disconnected clients are noticed
+const sseKeepAlive = 15 * time.Second
+
+// sseRetry is the reconnection delay suggested to EventSource clients
+const sseRetry = 3 * time.Second
+
+// beamEvents streams catalogue changes as Server-Sent Events. Each event is
+// named after its type, has the resume token as its id and the BeamEvent as
+// JSON data. A reconnecting client's Last-Event-ID header (or the
+// last_event_id query parameter) replays the events it missed.
+func (h *httpHandlers) beamEvents(c *fiber.Ctx) error {
+	lastEventID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	log.Printf("HTTP REST API: GET /beams/events called (Last-Event-ID: %q)", lastEventID)
+
+	// An expired token cannot be replayed; the client is told to reload
+	// with a reset event and then receives new events
+	var reset error
+	after, err := h.events.ParseResumeToken(lastEventID)
+	var (
+		backlog []BeamEvent
+		events  <-chan BeamEvent
+		cancel  func()
+	)
+	if err == nil {
+		backlog, events, cancel, err = h.events.Subscribe(after)
+	}
+	if errors.Is(err, ErrResumeTokenExpired) {
+		reset = err
+		backlog, events, cancel, err = h.events.Subscribe(0)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	conn := c.Context().Conn()
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		// Streams outlive the server's write timeout, so extend the
+		// deadline before every write
+		flush := func() error {
+			if err := conn.SetWriteDeadline(time.Now().Add(sseKeepAlive * 2)); err != nil {
+				return err
+			}
+			return w.Flush()
+		}
+
+		fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+		if reset != nil {
+			data, _ := json.Marshal(fiber.Map{"error": reset.Error()})
+			fmt.Fprintf(w, "event: reset\ndata: %s\n\n", data)
+		}
+		for _, event := range backlog {
+			h.writeBeamEvent(w, event)
+		}
+		if err := flush(); err != nil {
+			return
+		}
+
+		keepAlive := time.NewTicker(sseKeepAlive)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case <-h.closing:
+				return
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			case event, ok := <-events:
+				if !ok {
+					// Too far behind; the client reconnects with Last-Event-ID
+					return
+				}
+				h.writeBeamEvent(w, event)
+			}
+			if err := flush(); err != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// writeBeamEvent writes one event in text/event-stream format
+func (h *httpHandlers) writeBeamEvent(w *bufio.Writer, event BeamEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("HTTP REST API: failed to encode beam event %d: %v", event.ID, err)
+		return
+	}
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", h.events.ResumeToken(event), event.Type, data)
+}
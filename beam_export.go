@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// BeamFormat is a representation GET /beams can return
+type BeamFormat string
+
+const (
+	BeamFormatJSON   BeamFormat = "json"
+	BeamFormatCSV    BeamFormat = "csv"
+	BeamFormatXLSX   BeamFormat = "xlsx"
+	BeamFormatNDJSON BeamFormat = "ndjson"
+)
+
+// beamFormatTypes maps each format to its media type, in the order formats
+// are offered during content negotiation
+var beamFormatTypes = []struct {
+	Format    BeamFormat
+	MediaType string
+}{
+	{BeamFormatJSON, "application/json"},
+	{BeamFormatCSV, "text/csv"},
+	{BeamFormatXLSX, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+	{BeamFormatNDJSON, "application/x-ndjson"},
+}
+
+// beamFormatMediaType returns the media type of format
+func beamFormatMediaType(format BeamFormat) string {
+	for _, t := range beamFormatTypes {
+		if t.Format == format {
+			return t.MediaType
+		}
+	}
+	return ""
+}
+
+// ParseBeamFormat returns the format named by a format query parameter
+func ParseBeamFormat(value string) (BeamFormat, error) {
+	if beamFormatMediaType(BeamFormat(value)) == "" {
+		return "", fmt.Errorf("format must be one of json, csv, xlsx or ndjson")
+	}
+	return BeamFormat(value), nil
+}
+
+// beamCell returns the value of column in beam: a string for the
+// designation and a float64 otherwise
+func beamCell(beam SteelBeam, column beamColumn) any {
+	field := reflect.ValueOf(beam).Field(column.Index)
+	if field.Kind() == reflect.String {
+		return field.String()
+	}
+	return field.Float()
+}
+
+// beamColumnNames returns the JSON tags of columns, used as export headers
+func beamColumnNames(columns []beamColumn) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+// WriteBeamsCSV writes beams as a section table with a header row, in the
+// format POST /beams/import and the built-in tables read
+func WriteBeamsCSV(w io.Writer, beams []SteelBeam, columns []beamColumn) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(beamColumnNames(columns)); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, beam := range beams {
+		for i, column := range columns {
+			switch value := beamCell(beam, column).(type) {
+			case string:
+				record[i] = value
+			case float64:
+				record[i] = strconv.FormatFloat(value, 'f', -1, 64)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteBeamsNDJSON writes one JSON object per line for each beam
+func WriteBeamsNDJSON(w io.Writer, beams []SteelBeam, columns []beamColumn) error {
+	for _, beam := range beams {
+		line, err := json.Marshal(beamProjection{beam: beam, columns: columns})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteBeamsXLSX writes beams as a spreadsheet with one row per beam
+func WriteBeamsXLSX(w io.Writer, beams []SteelBeam, columns []beamColumn) error {
+	rows := make([][]any, len(beams))
+	for i, beam := range beams {
+		row := make([]any, len(columns))
+		for j, column := range columns {
+			row[j] = beamCell(beam, column)
+		}
+		rows[i] = row
+	}
+	return writeXLSX(w, "Beams", beamColumnNames(columns), rows)
+}
+
+// beamProjection encodes the selected columns of a beam as a JSON object,
+// keeping SteelBeam field order
+type beamProjection struct {
+	beam    SteelBeam
+	columns []beamColumn
+}
+
+func (p beamProjection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, column := range p.columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(column.Name)
+		value, err := json.Marshal(beamCell(p.beam, column))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// projectBeams returns beams reduced to the selected columns for JSON output
+func projectBeams(beams []SteelBeam, columns []beamColumn) []beamProjection {
+	projected := make([]beamProjection, len(beams))
+	for i, beam := range beams {
+		projected[i] = beamProjection{beam: beam, columns: columns}
+	}
+	return projected
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const xlsxMediaType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// newTestExportApp serves GET /beams over the built-in tables
+func newTestExportApp(t *testing.T) *fiber.App {
+	t.Helper()
+	silenceLog(t)
+	repo := NewMemoryBeamRepository(testSeedBeams(t))
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	return newTestHTTPApp(repo, NewBeamService(repo, events), events)
+}
+
+// getExport sends GET target with the given Accept header, which is left
+// out when empty, and returns the response and its body
+func getExport(t *testing.T, app *fiber.App, target, accept string) (*http.Response, []byte) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, body
+}
+
+func TestGetBeamsNegotiatesFormat(t *testing.T) {
+	app := newTestExportApp(t)
+	tests := []struct {
+		name        string
+		query       string
+		accept      string
+		status      int
+		contentType string
+	}{
+		{"no Accept", "", "", http.StatusOK, "application/json"},
+		{"any type", "", "*/*", http.StatusOK, "application/json"},
+		{"csv", "", "text/csv", http.StatusOK, "text/csv"},
+		{"xlsx", "", xlsxMediaType, http.StatusOK, xlsxMediaType},
+		{"ndjson", "", "application/x-ndjson", http.StatusOK, "application/x-ndjson"},
+		{"quality", "", "text/csv;q=0.5, application/x-ndjson", http.StatusOK, "application/x-ndjson"},
+		{"format beats Accept", "format=csv", "application/json", http.StatusOK, "text/csv"},
+		{"format without Accept", "format=xlsx", "", http.StatusOK, xlsxMediaType},
+		{"unknown format", "format=pdf", "", http.StatusBadRequest, "application/json"},
+		{"nothing acceptable", "", "text/html", http.StatusNotAcceptable, "application/json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := getExport(t, app, "/beams?limit=2&"+tt.query, tt.accept)
+			if resp.StatusCode != tt.status {
+				t.Fatalf("status %d, want %d: %s", resp.StatusCode, tt.status, body)
+			}
+			if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, tt.contentType) {
+				t.Errorf("Content-Type %q, want %q", got, tt.contentType)
+			}
+			if tt.status == http.StatusOK && tt.contentType != "application/json" {
+				if got := resp.Header.Get("X-Total-Count"); got != strconv.Itoa(len(testSeedBeams(t))) {
+					t.Errorf("X-Total-Count %q, want the catalogue size", got)
+				}
+				if got := resp.Header.Get("X-Next-Offset"); got != "2" {
+					t.Errorf("X-Next-Offset %q, want 2", got)
+				}
+			}
+		})
+	}
+}
+
+// Selected fields come out in SteelBeam order with the designation first,
+// whatever order they were asked for in
+func TestGetBeamsFieldsColumnOrder(t *testing.T) {
+	app := newTestExportApp(t)
+	const fields = "fields=plastic_modulus_axis_y,depth_of_section,mass_per_metre"
+	want := []string{"section_designation", "mass_per_metre", "depth_of_section", "plastic_modulus_axis_y"}
+
+	_, body := getExport(t, app, "/beams?limit=1&format=csv&"+fields, "")
+	header, _, _ := strings.Cut(string(body), "\n")
+	if got := strings.Split(strings.TrimSpace(header), ","); !reflect.DeepEqual(got, want) {
+		t.Errorf("CSV header %v, want %v", got, want)
+	}
+
+	_, body = getExport(t, app, "/beams?limit=1&format=ndjson&"+fields, "")
+	if got := jsonKeys(t, bytes.TrimSpace(body)); !reflect.DeepEqual(got, want) {
+		t.Errorf("NDJSON keys %v, want %v", got, want)
+	}
+
+	_, body = getExport(t, app, "/beams?limit=1&"+fields, "")
+	var page struct {
+		Beams []json.RawMessage `json:"beams"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil || len(page.Beams) != 1 {
+		t.Fatalf("JSON page %s: %v", body, err)
+	}
+	if got := jsonKeys(t, page.Beams[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("JSON keys %v, want %v", got, want)
+	}
+
+	_, body = getExport(t, app, "/beams?limit=1&format=xlsx&"+fields, "")
+	rows := readXLSXRows(t, body)
+	if got := rows[0].values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("XLSX header %v, want %v", got, want)
+	}
+}
+
+// jsonKeys returns the keys of a JSON object in document order
+func jsonKeys(t *testing.T, object []byte) []string {
+	t.Helper()
+	decoder := json.NewDecoder(bytes.NewReader(object))
+	if _, err := decoder.Token(); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key.(string))
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+// A full CSV export reads back as the catalogue it was written from
+func TestWriteBeamsCSVRoundTrip(t *testing.T) {
+	seed := testSeedBeams(t)
+	var buf bytes.Buffer
+	if err := WriteBeamsCSV(&buf, seed, beamColumns); err != nil {
+		t.Fatal(err)
+	}
+	beams, _, err := ParseBeamsCSV("export.csv", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(beams, seed) {
+		t.Fatalf("round trip returned %d beams that differ from the %d exported", len(beams), len(seed))
+	}
+}
+
+// xlsxRow is a <row> of a worksheet as the XLSX writer produces it
+type xlsxRow struct {
+	Ref   string `xml:"r,attr"`
+	Cells []struct {
+		Ref    string `xml:"r,attr"`
+		Type   string `xml:"t,attr"`
+		Value  string `xml:"v"`
+		Inline string `xml:"is>t"`
+	} `xml:"c"`
+}
+
+// values returns the text of each cell, inline strings and numbers alike
+func (r xlsxRow) values() []string {
+	values := make([]string, len(r.Cells))
+	for i, cell := range r.Cells {
+		values[i] = cell.Value
+		if cell.Type == "inlineStr" {
+			values[i] = cell.Inline
+		}
+	}
+	return values
+}
+
+// readXLSXRows checks workbook holds every part a spreadsheet needs, each
+// well-formed XML, and returns the rows of its sheet
+func readXLSXRows(t *testing.T, workbook []byte) []xlsxRow {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil {
+		t.Fatalf("not a zip archive: %v", err)
+	}
+	parts := map[string]*zip.File{}
+	for _, f := range archive.File {
+		parts[f.Name] = f
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoder := xml.NewDecoder(bufio.NewReader(r))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed: %v", f.Name, err)
+			}
+		}
+		r.Close()
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml"} {
+		if parts[name] == nil {
+			t.Fatalf("workbook has no %s", name)
+		}
+	}
+
+	r, err := parts["xl/worksheets/sheet1.xml"].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	var sheet struct {
+		Rows []xlsxRow `xml:"sheetData>row"`
+	}
+	if err := xml.NewDecoder(r).Decode(&sheet); err != nil {
+		t.Fatal(err)
+	}
+	return sheet.Rows
+}
+
+func TestWriteBeamsXLSX(t *testing.T) {
+	beams := testSeedBeams(t)[:3]
+	var buf bytes.Buffer
+	if err := WriteBeamsXLSX(&buf, beams, beamColumns); err != nil {
+		t.Fatal(err)
+	}
+	rows := readXLSXRows(t, buf.Bytes())
+	if len(rows) != len(beams)+1 {
+		t.Fatalf("sheet has %d rows, want a header and %d beams", len(rows), len(beams))
+	}
+	if got := rows[0].values(); !reflect.DeepEqual(got, beamColumnNames(beamColumns)) {
+		t.Errorf("header %v, want the column names", got)
+	}
+	for i, beam := range beams {
+		row := rows[i+1]
+		if want := strconv.Itoa(i + 2); row.Ref != want {
+			t.Errorf("row %d numbered %s", i+2, row.Ref)
+		}
+		if len(row.Cells) != len(beamColumns) {
+			t.Fatalf("row %s has %d cells, want %d", row.Ref, len(row.Cells), len(beamColumns))
+		}
+		for j, column := range beamColumns {
+			cell := row.Cells[j]
+			if want := xlsxColumnName(j) + row.Ref; cell.Ref != want {
+				t.Errorf("cell %s, want %s", cell.Ref, want)
+			}
+			switch want := beamCell(beam, column).(type) {
+			case string:
+				if cell.Type != "inlineStr" || cell.Inline != want {
+					t.Errorf("%s %s = %q (%s), want string %q", row.Ref, column.Name, cell.Inline, cell.Type, want)
+				}
+			case float64:
+				got, err := strconv.ParseFloat(cell.Value, 64)
+				if cell.Type != "" || err != nil || got != want {
+					t.Errorf("%s %s = %q (%s), want number %g", row.Ref, column.Name, cell.Value, cell.Type, want)
+				}
+			}
+		}
+	}
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 51: "AZ", 701: "ZZ", 702: "AAA"} {
+		if got := xlsxColumnName(index); got != want {
+			t.Errorf("xlsxColumnName(%d) = %s, want %s", index, got, want)
+		}
+	}
+}
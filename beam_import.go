@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ImportMode decides what an import does with a beam that is already stored
+type ImportMode string
+
+const (
+	// ImportInsert rejects rows whose designation is already stored
+	ImportInsert ImportMode = "insert"
+	// ImportUpsert replaces stored beams with the imported rows
+	ImportUpsert ImportMode = "upsert"
+)
+
+// ParseImportMode returns the mode named by value; empty means insert-only
+func ParseImportMode(value string) (ImportMode, error) {
+	switch mode := ImportMode(value); mode {
+	case "":
+		return ImportInsert, nil
+	case ImportInsert, ImportUpsert:
+		return mode, nil
+	}
+	return "", fmt.Errorf("mode must be %q or %q", ImportInsert, ImportUpsert)
+}
+
+// ImportOptions controls how a BeamImport applies its rows
+type ImportOptions struct {
+	Mode ImportMode
+	// DryRun checks every row and reports what would happen without
+	// writing anything
+	DryRun bool
+	// Derive fills properties left at zero from each row's geometry, as
+	// POST /beams?derive=true does
+	Derive bool
+}
+
+// ImportAction is the outcome of one imported row
+type ImportAction string
+
+const (
+	ImportCreated ImportAction = "created"
+	ImportUpdated ImportAction = "updated"
+	ImportFailed  ImportAction = "failed"
+)
+
+// ImportRowResult reports one row of an import. Row is the CSV line number,
+// or the 1-based position in a JSON array or gRPC stream. Errors lists
+// field-level problems when the row failed validation.
+type ImportRowResult struct {
+	Row                int          `json:"row"`
+	SectionDesignation string       `json:"section_designation,omitempty"`
+	Action             ImportAction `json:"action"`
+	Error              string       `json:"error,omitempty"`
+	Errors             []FieldError `json:"errors,omitempty"`
+}
+
+// ImportReport summarises an import. In a dry run the actions are those
+// that would have been taken.
+type ImportReport struct {
+	Mode    ImportMode        `json:"mode"`
+	DryRun  bool              `json:"dry_run"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Rows    []ImportRowResult `json:"rows"`
+}
+
+// BeamImport applies rows to the catalogue one at a time as they are read,
+// so a stream of any length can be imported. Rows are independent: a failed
+// row is reported and the rest are still applied. A designation may appear
+// only once per import.
+type BeamImport struct {
+	service *BeamService
+	options ImportOptions
+	// seen maps each designation imported so far to its row
+	seen   map[string]int
+	report ImportReport
+}
+
+// NewImport starts an import into the catalogue
+func (s *BeamService) NewImport(options ImportOptions) *BeamImport {
+	if options.Mode == "" {
+		options.Mode = ImportInsert
+	}
+	return &BeamImport{
+		service: s,
+		options: options,
+		seen:    map[string]int{},
+		report:  ImportReport{Mode: options.Mode, DryRun: options.DryRun, Rows: []ImportRowResult{}},
+	}
+}
+
+// Add validates and, unless this is a dry run, stores the beam read from row
+func (i *BeamImport) Add(row int, beam SteelBeam) ImportRowResult {
+	if first, ok := i.seen[beam.SectionDesignation]; ok && beam.SectionDesignation != "" {
+		return i.Fail(row, beam.SectionDesignation, &ValidationError{Errors: []FieldError{{
+			Field:   "section_designation",
+			Message: fmt.Sprintf("is repeated (first imported at row %d)", first),
+		}}})
+	}
+
+	if i.options.Derive {
+		derived, err := FillDerivedProperties(beam)
+		if err != nil {
+			return i.Fail(row, beam.SectionDesignation, err)
+		}
+		beam = derived
+	}
+
+	action, err := i.apply(beam)
+	if err != nil {
+		return i.Fail(row, beam.SectionDesignation, err)
+	}
+	i.seen[beam.SectionDesignation] = row
+	return i.record(ImportRowResult{Row: row, SectionDesignation: beam.SectionDesignation, Action: action})
+}
+
+// apply stores beam according to the import mode, or works out what storing
+// it would do in a dry run
+func (i *BeamImport) apply(beam SteelBeam) (ImportAction, error) {
+	if i.options.DryRun {
+		if err := ValidateBeam(beam); err != nil {
+			return "", err
+		}
+		_, err := i.service.repo.Get(beam.SectionDesignation)
+		switch {
+		case errors.Is(err, ErrBeamNotFound):
+			return ImportCreated, nil
+		case err != nil:
+			return "", err
+		case i.options.Mode == ImportUpsert:
+			return ImportUpdated, nil
+		}
+		return "", ErrBeamExists
+	}
+
+	_, err := i.service.CreateBeam(beam)
+	if errors.Is(err, ErrBeamExists) && i.options.Mode == ImportUpsert {
+		if _, err := i.service.UpdateBeam(beam.SectionDesignation, beam); err != nil {
+			return "", err
+		}
+		return ImportUpdated, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return ImportCreated, nil
+}
+
+// Fail records a row that could not be read or stored
+func (i *BeamImport) Fail(row int, sectionDesignation string, err error) ImportRowResult {
+	result := ImportRowResult{Row: row, SectionDesignation: sectionDesignation, Action: ImportFailed, Error: err.Error()}
+	var invalid *ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		result.Error = "Validation failed"
+		result.Errors = invalid.Errors
+	case errors.Is(err, ErrBeamExists):
+		result.Error = "Beam already exists"
+		result.Errors = []FieldError{{Field: "section_designation", Message: "already exists"}}
+	}
+	return i.record(result)
+}
+
+func (i *BeamImport) record(result ImportRowResult) ImportRowResult {
+	i.report.Total++
+	switch result.Action {
+	case ImportCreated:
+		i.report.Created++
+	case ImportUpdated:
+		i.report.Updated++
+	case ImportFailed:
+		i.report.Failed++
+	}
+	i.report.Rows = append(i.report.Rows, result)
+	return result
+}
+
+// Report returns the results of every row added so far
+func (i *BeamImport) Report() ImportReport {
+	return i.report
+}
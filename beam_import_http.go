@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"reflect"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// importBeams loads many beams in one request. The body is either a CSV
+// section table (Content-Type: text/csv) whose header names SteelBeam JSON
+// tags, or a JSON array of beams. Query parameters select the mode
+// (insert or upsert), dry_run and derive. The response reports every row.
+func (h *httpHandlers) importBeams(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: POST /beams/import called")
+
+	mode, err := ParseImportMode(c.Query("mode"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	importer := h.beams.NewImport(ImportOptions{
+		Mode:   mode,
+		DryRun: c.QueryBool("dry_run"),
+		Derive: c.QueryBool("derive"),
+	})
+
+	mediaType, _, _ := mime.ParseMediaType(c.Get(fiber.HeaderContentType))
+	switch mediaType {
+	case "text/csv":
+		err = ScanBeamsCSV("request body", bytes.NewReader(c.Body()), func(line int, beam SteelBeam, err error) {
+			if err != nil {
+				importer.Fail(line, beam.SectionDesignation, err)
+				return
+			}
+			importer.Add(line, beam)
+		})
+	case fiber.MIMEApplicationJSON:
+		err = importBeamsJSON(importer, c.Body())
+	default:
+		return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{
+			"error":  "Content-Type must be text/csv or application/json",
+			"source": "http_rest_api",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	report := importer.Report()
+	log.Printf("HTTP REST API: import of %d rows (dry run: %t): %d created, %d updated, %d failed",
+		report.Total, report.DryRun, report.Created, report.Updated, report.Failed)
+	return c.JSON(fiber.Map{
+		"import": report,
+		"source": "http_rest_api",
+	})
+}
+
+// importBeamsJSON adds each element of a JSON array of beams to importer.
+// Elements are decoded separately, so a malformed or misspelt field fails
+// only its own row.
+func importBeamsJSON(importer *BeamImport, body []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(body, &elements); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return errors.New("body must be a JSON array of beams")
+		}
+		return fmt.Errorf("body must be a JSON array of beams: %w", err)
+	}
+	for i, element := range elements {
+		row := i + 1
+		var beam SteelBeam
+		decoder := json.NewDecoder(bytes.NewReader(element))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&beam); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				err = &ValidationError{Errors: []FieldError{{Field: typeErr.Field, Message: "must be a " + jsonTypeName(typeErr.Type.Kind())}}}
+			}
+			importer.Fail(row, beam.SectionDesignation, err)
+			continue
+		}
+		importer.Add(row, beam)
+	}
+	return nil
+}
+
+// jsonTypeName describes a SteelBeam field type in JSON terms
+func jsonTypeName(kind reflect.Kind) string {
+	if kind == reflect.String {
+		return "string"
+	}
+	return "number"
+}
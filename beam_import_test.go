@@ -0,0 +1,173 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBeamImport(t *testing.T) {
+	existing := catalogueBeam(t, "UB406x178x74")
+	heavier := existing
+	heavier.MassPerMetre++
+	newBeam := func(designation string) SteelBeam {
+		beam := existing
+		beam.SectionDesignation = designation
+		return beam
+	}
+	noArea := newBeam("UB406x178x75")
+	noArea.AreaOfSection = 0
+	invalid := newBeam("406x178")
+
+	exists := []FieldError{{Field: "section_designation", Message: "already exists"}}
+	tests := []struct {
+		name    string
+		options ImportOptions
+		rows    []SteelBeam
+		want    []ImportRowResult
+		// stored maps every designation in the catalogue afterwards to its mass
+		stored map[string]float64
+	}{
+		{
+			name:    "insert creates new beams",
+			options: ImportOptions{Mode: ImportInsert},
+			rows:    []SteelBeam{newBeam("UB406x178x75"), newBeam("UB406x178x76")},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+				{Row: 2, SectionDesignation: "UB406x178x76", Action: ImportCreated},
+			},
+			stored: map[string]float64{"UB406x178x74": 74.2, "UB406x178x75": 74.2, "UB406x178x76": 74.2},
+		},
+		{
+			name:    "insert rejects stored beams",
+			options: ImportOptions{Mode: ImportInsert},
+			rows:    []SteelBeam{heavier, newBeam("UB406x178x75")},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x74", Action: ImportFailed, Error: "Beam already exists", Errors: exists},
+				{Row: 2, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+			},
+			stored: map[string]float64{"UB406x178x74": 74.2, "UB406x178x75": 74.2},
+		},
+		{
+			name:    "upsert replaces stored beams",
+			options: ImportOptions{Mode: ImportUpsert},
+			rows:    []SteelBeam{heavier, newBeam("UB406x178x75")},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x74", Action: ImportUpdated},
+				{Row: 2, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+			},
+			stored: map[string]float64{"UB406x178x74": 75.2, "UB406x178x75": 74.2},
+		},
+		{
+			name:    "dry run insert writes nothing",
+			options: ImportOptions{Mode: ImportInsert, DryRun: true},
+			rows:    []SteelBeam{newBeam("UB406x178x75"), heavier},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+				{Row: 2, SectionDesignation: "UB406x178x74", Action: ImportFailed, Error: "Beam already exists", Errors: exists},
+			},
+			stored: map[string]float64{"UB406x178x74": 74.2},
+		},
+		{
+			name:    "dry run upsert writes nothing",
+			options: ImportOptions{Mode: ImportUpsert, DryRun: true},
+			rows:    []SteelBeam{heavier, newBeam("UB406x178x75"), invalid},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x74", Action: ImportUpdated},
+				{Row: 2, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+				{Row: 3, SectionDesignation: "406x178", Action: ImportFailed, Error: "Validation failed", Errors: []FieldError{{
+					Field:   "section_designation",
+					Message: "must be a family prefix followed by dimensions separated by x, e.g. UB406x178x74",
+				}}},
+			},
+			stored: map[string]float64{"UB406x178x74": 74.2},
+		},
+		{
+			name:    "designation repeated within the import",
+			options: ImportOptions{Mode: ImportUpsert},
+			rows:    []SteelBeam{newBeam("UB406x178x75"), heavier, newBeam("UB406x178x75")},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "UB406x178x75", Action: ImportCreated},
+				{Row: 2, SectionDesignation: "UB406x178x74", Action: ImportUpdated},
+				{Row: 3, SectionDesignation: "UB406x178x75", Action: ImportFailed, Error: "Validation failed", Errors: []FieldError{{
+					Field:   "section_designation",
+					Message: "is repeated (first imported at row 1)",
+				}}},
+			},
+			stored: map[string]float64{"UB406x178x74": 75.2, "UB406x178x75": 74.2},
+		},
+		{
+			name:    "failed rows do not stop the import",
+			options: ImportOptions{Mode: ImportInsert},
+			rows:    []SteelBeam{invalid, noArea, newBeam("UB406x178x76")},
+			want: []ImportRowResult{
+				{Row: 1, SectionDesignation: "406x178", Action: ImportFailed, Error: "Validation failed", Errors: []FieldError{{
+					Field:   "section_designation",
+					Message: "must be a family prefix followed by dimensions separated by x, e.g. UB406x178x74",
+				}}},
+				{Row: 2, SectionDesignation: "UB406x178x75", Action: ImportFailed, Error: "Validation failed", Errors: []FieldError{{
+					Field:   "area_of_section",
+					Message: "is required and must be positive",
+				}}},
+				{Row: 3, SectionDesignation: "UB406x178x76", Action: ImportCreated},
+			},
+			stored: map[string]float64{"UB406x178x74": 74.2, "UB406x178x76": 74.2},
+		},
+		{
+			name:    "derive fills missing properties",
+			options: ImportOptions{Mode: ImportInsert, Derive: true},
+			rows:    []SteelBeam{noArea},
+			want:    []ImportRowResult{{Row: 1, SectionDesignation: "UB406x178x75", Action: ImportCreated}},
+			stored:  map[string]float64{"UB406x178x74": 74.2, "UB406x178x75": 74.2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMemoryBeamRepository([]SteelBeam{existing})
+			service := NewBeamService(repo, NewBeamEventLog(defaultBeamEventLogSize))
+			imp := service.NewImport(tt.options)
+			for i, beam := range tt.rows {
+				imp.Add(i+1, beam)
+			}
+
+			report := imp.Report()
+			if !reflect.DeepEqual(report.Rows, tt.want) {
+				t.Errorf("rows:\n got %+v\nwant %+v", report.Rows, tt.want)
+			}
+			counts := map[ImportAction]int{}
+			for _, row := range tt.want {
+				counts[row.Action]++
+			}
+			if report.Mode != tt.options.Mode || report.DryRun != tt.options.DryRun || report.Total != len(tt.rows) ||
+				report.Created != counts[ImportCreated] || report.Updated != counts[ImportUpdated] || report.Failed != counts[ImportFailed] {
+				t.Errorf("report %s dry run %v: %d total, %d created, %d updated, %d failed; want %v",
+					report.Mode, report.DryRun, report.Total, report.Created, report.Updated, report.Failed, counts)
+			}
+
+			beams, err := repo.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			stored := map[string]float64{}
+			for _, beam := range beams {
+				stored[beam.SectionDesignation] = beam.MassPerMetre
+				if beam.AreaOfSection <= 0 {
+					t.Errorf("%s stored without an area", beam.SectionDesignation)
+				}
+			}
+			if !reflect.DeepEqual(stored, tt.stored) {
+				t.Errorf("catalogue %v, want %v", stored, tt.stored)
+			}
+		})
+	}
+}
+
+func TestParseImportMode(t *testing.T) {
+	for value, want := range map[string]ImportMode{"": ImportInsert, "insert": ImportInsert, "upsert": ImportUpsert} {
+		if got, err := ParseImportMode(value); err != nil || got != want {
+			t.Errorf("ParseImportMode(%q) = %s, %v; want %s", value, got, err, want)
+		}
+	}
+	if _, err := ParseImportMode("replace"); err == nil {
+		t.Error("ParseImportMode(replace): expected an error")
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxBeamQueryPaging bounds offset and limit to the range of the int32
+// fields carrying them over gRPC
+const maxBeamQueryPaging = math.MaxInt32
+
+// BeamRangeFilter keeps beams whose numeric Field lies within [Min, Max].
+// A nil bound is open.
+type BeamRangeFilter struct {
+	Field string
+	Min   *float64
+	Max   *float64
+}
+
+// BeamQuery filters, sorts and paginates the beam catalogue. Fields are
+// named by their SteelBeam JSON tags; a Limit of zero returns every match.
+// Fields selects the properties returned; empty means all of them.
+type BeamQuery struct {
+	Filters    []BeamRangeFilter
+	SortBy     string
+	Descending bool
+	Offset     int
+	Limit      int
+	Fields     []string
+}
+
+// BeamPage is one page of query results
+type BeamPage struct {
+	Beams []SteelBeam
+	// Total is the number of beams matching the filters before pagination
+	Total int
+	// NextOffset is the offset of the following page, or zero on the last page
+	NextOffset int
+}
+
+// numericBeamColumn returns the column for a float64 SteelBeam field
+func numericBeamColumn(name string) (beamColumn, error) {
+	column, ok := beamColumnByName(name)
+	if !ok {
+		return beamColumn{}, fmt.Errorf("unknown beam field %q", name)
+	}
+	if reflect.TypeOf(SteelBeam{}).Field(column.Index).Type.Kind() != reflect.Float64 {
+		return beamColumn{}, fmt.Errorf("beam field %q is not numeric", name)
+	}
+	return column, nil
+}
+
+// Validate checks that every referenced field exists, filter bounds are
+// finite and the paging values are sane
+func (q BeamQuery) Validate() error {
+	for _, filter := range q.Filters {
+		if _, err := numericBeamColumn(filter.Field); err != nil {
+			return err
+		}
+		for _, bound := range []*float64{filter.Min, filter.Max} {
+			if bound != nil && (math.IsNaN(*bound) || math.IsInf(*bound, 0)) {
+				return fmt.Errorf("bounds on %s must be finite numbers", filter.Field)
+			}
+		}
+		if filter.Min != nil && filter.Max != nil && *filter.Min > *filter.Max {
+			return fmt.Errorf("min_%s is greater than max_%s", filter.Field, filter.Field)
+		}
+	}
+	if q.SortBy != "" {
+		if _, ok := beamColumnByName(q.SortBy); !ok {
+			return fmt.Errorf("unknown sort field %q", q.SortBy)
+		}
+	}
+	for _, field := range q.Fields {
+		if _, ok := beamColumnByName(field); !ok {
+			return fmt.Errorf("unknown field %q", field)
+		}
+	}
+	if q.Offset < 0 || q.Offset > maxBeamQueryPaging {
+		return fmt.Errorf("offset must be between 0 and %d", maxBeamQueryPaging)
+	}
+	if q.Limit < 0 || q.Limit > maxBeamQueryPaging {
+		return fmt.Errorf("limit must be between 0 and %d", maxBeamQueryPaging)
+	}
+	return nil
+}
+
+// Columns returns the selected fields in SteelBeam struct order, so output
+// columns are stable however the fields were listed. section_designation
+// is always included. The query must have been validated.
+func (q BeamQuery) Columns() []beamColumn {
+	if len(q.Fields) == 0 {
+		return beamColumns
+	}
+	selected := map[string]bool{"section_designation": true}
+	for _, field := range q.Fields {
+		selected[field] = true
+	}
+	columns := make([]beamColumn, 0, len(selected))
+	for _, column := range beamColumns {
+		if selected[column.Name] {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// Apply runs the query against beams. The query must have been validated.
+func (q BeamQuery) Apply(beams []SteelBeam) BeamPage {
+	matched := make([]SteelBeam, 0, len(beams))
+	for _, beam := range beams {
+		if q.matches(beam) {
+			matched = append(matched, beam)
+		}
+	}
+
+	if q.SortBy != "" {
+		column, _ := beamColumnByName(q.SortBy)
+		sort.SliceStable(matched, func(i, j int) bool {
+			a := reflect.ValueOf(matched[i]).Field(column.Index)
+			b := reflect.ValueOf(matched[j]).Field(column.Index)
+			if a.Kind() == reflect.String {
+				if q.Descending {
+					return a.String() > b.String()
+				}
+				return a.String() < b.String()
+			}
+			if q.Descending {
+				return a.Float() > b.Float()
+			}
+			return a.Float() < b.Float()
+		})
+	}
+
+	page := BeamPage{Total: len(matched)}
+	start := min(q.Offset, len(matched))
+	end := len(matched)
+	// Compared without adding so a huge limit cannot overflow
+	if q.Limit > 0 && q.Limit < end-start {
+		end = start + q.Limit
+		page.NextOffset = end
+	}
+	page.Beams = matched[start:end]
+	return page
+}
+
+func (q BeamQuery) matches(beam SteelBeam) bool {
+	v := reflect.ValueOf(beam)
+	for _, filter := range q.Filters {
+		column, _ := beamColumnByName(filter.Field)
+		value := v.Field(column.Index).Float()
+		if filter.Min != nil && value < *filter.Min {
+			return false
+		}
+		if filter.Max != nil && value > *filter.Max {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseBeamQuery builds a query from URL parameters:
+//
+//	min_<field>=<n>, max_<field>=<n>   range filters on numeric fields
+//	sort=<field>, order=asc|desc        sorting
+//	offset=<n>, limit=<n>               pagination
+//	fields=<field>,<field>              properties to return
+func ParseBeamQuery(params map[string]string) (BeamQuery, error) {
+	var q BeamQuery
+	filters := map[string]*BeamRangeFilter{}
+	filter := func(field string) *BeamRangeFilter {
+		if f, ok := filters[field]; ok {
+			return f
+		}
+		f := &BeamRangeFilter{Field: field}
+		filters[field] = f
+		return f
+	}
+
+	for key, value := range params {
+		switch {
+		case strings.HasPrefix(key, "min_"), strings.HasPrefix(key, "max_"):
+			bound, field := key[:3], key[4:]
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+				return BeamQuery{}, fmt.Errorf("%s must be a number", key)
+			}
+			if bound == "min" {
+				filter(field).Min = &n
+			} else {
+				filter(field).Max = &n
+			}
+		case key == "sort":
+			q.SortBy = value
+		case key == "order":
+			switch strings.ToLower(value) {
+			case "", "asc":
+				q.Descending = false
+			case "desc":
+				q.Descending = true
+			default:
+				return BeamQuery{}, fmt.Errorf("order must be asc or desc")
+			}
+		case key == "fields":
+			for _, field := range strings.Split(value, ",") {
+				if field = strings.TrimSpace(field); field != "" {
+					q.Fields = append(q.Fields, field)
+				}
+			}
+		case key == "offset", key == "limit":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return BeamQuery{}, fmt.Errorf("%s must be an integer", key)
+			}
+			if key == "offset" {
+				q.Offset = n
+			} else {
+				q.Limit = n
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(filters))
+	for field := range filters {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		q.Filters = append(q.Filters, *filters[field])
+	}
+	return q, q.Validate()
+}
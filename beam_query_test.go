@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+
+	pb "formandfunction-api/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// A limit near the integer maximum must not overflow start+limit in Apply
+func TestBeamQueryApplyHugeLimit(t *testing.T) {
+	seed := testSeedBeams(t)
+	page := BeamQuery{Offset: 1, Limit: math.MaxInt}.Apply(seed)
+	if len(page.Beams) != len(seed)-1 || page.Total != len(seed) || page.NextOffset != 0 {
+		t.Fatalf("got %d beams of %d, next offset %d; want %d of %d on the last page",
+			len(page.Beams), page.Total, page.NextOffset, len(seed)-1, len(seed))
+	}
+}
+
+func TestGetBeamsRejectsOversizedPaging(t *testing.T) {
+	silenceLog(t)
+
+	repo := NewMemoryBeamRepository(testSeedBeams(t))
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	app := newTestHTTPApp(repo, NewBeamService(repo, events), events)
+
+	maxInt := strconv.Itoa(math.MaxInt)
+	for _, target := range []string{
+		"/beams?offset=1&limit=" + maxInt,
+		"/beams?offset=" + maxInt + "&limit=1",
+	} {
+		if err := httpExpect(app, http.MethodGet, target, nil, fiber.StatusBadRequest); err != nil {
+			t.Errorf("GET %s: %v", target, err)
+		}
+	}
+	largest := "/beams?offset=1&limit=" + strconv.Itoa(math.MaxInt32)
+	if err := httpExpect(app, http.MethodGet, largest, nil, fiber.StatusOK); err != nil {
+		t.Errorf("GET %s: %v", largest, err)
+	}
+}
+
+func TestGetBeamsRPCHugeLimit(t *testing.T) {
+	silenceLog(t)
+
+	seed := testSeedBeams(t)
+	repo := NewMemoryBeamRepository(seed)
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	client := newTestGRPCClient(t, repo, NewBeamService(repo, events), events)
+
+	resp, err := client.GetBeams(context.Background(), &pb.GetBeamsRequest{Offset: 1, Limit: math.MaxInt32})
+	if err != nil {
+		t.Fatalf("GetBeams: %v", err)
+	}
+	if len(resp.Beams) != len(seed)-1 || resp.NextOffset != 0 {
+		t.Fatalf("got %d beams, next offset %d; want %d on the last page", len(resp.Beams), resp.NextOffset, len(seed)-1)
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// BeamSelection asks for the lightest beams satisfying every minimum and
+// maximum, keyed by SteelBeam JSON tag. A Limit of zero returns every candidate.
+type BeamSelection struct {
+	Minimums map[string]float64 `json:"minimums"`
+	Maximums map[string]float64 `json:"maximums"`
+	Limit    int                `json:"limit"`
+}
+
+// GoverningConstraint is the constraint a candidate satisfies with the least
+// margin. Utilisation is required/provided for minimums and provided/limit
+// for maximums, so it never exceeds 1 for a candidate.
+type GoverningConstraint struct {
+	Field       string  `json:"field"`
+	Bound       string  `json:"bound"`
+	Limit       float64 `json:"limit"`
+	Value       float64 `json:"value"`
+	Utilisation float64 `json:"utilisation"`
+}
+
+// BeamCandidate is a beam that satisfies a selection
+type BeamCandidate struct {
+	Beam      SteelBeam           `json:"beam"`
+	Governing GoverningConstraint `json:"governing"`
+}
+
+type selectionConstraint struct {
+	field  string
+	bound  string
+	limit  float64
+	column beamColumn
+}
+
+// constraints validates the selection and flattens it into a sorted list
+func (s BeamSelection) constraints() ([]selectionConstraint, error) {
+	if len(s.Minimums) == 0 && len(s.Maximums) == 0 {
+		return nil, errors.New("at least one minimum or maximum is required")
+	}
+	if s.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	var constraints []selectionConstraint
+	add := func(bound string, values map[string]float64) error {
+		for field, limit := range values {
+			column, err := numericBeamColumn(field)
+			if err != nil {
+				return err
+			}
+			if bound == "min" && limit < 0 {
+				return fmt.Errorf("minimum %s must not be negative", field)
+			}
+			if bound == "max" && limit <= 0 {
+				return fmt.Errorf("maximum %s must be positive", field)
+			}
+			constraints = append(constraints, selectionConstraint{field: field, bound: bound, limit: limit, column: column})
+		}
+		return nil
+	}
+	if err := add("min", s.Minimums); err != nil {
+		return nil, err
+	}
+	if err := add("max", s.Maximums); err != nil {
+		return nil, err
+	}
+
+	// Sort so ties between equally utilised constraints resolve deterministically
+	sort.Slice(constraints, func(i, j int) bool {
+		if constraints[i].field != constraints[j].field {
+			return constraints[i].field < constraints[j].field
+		}
+		return constraints[i].bound > constraints[j].bound
+	})
+	return constraints, nil
+}
+
+// SelectBeams returns the beams satisfying the selection, lightest first
+func SelectBeams(beams []SteelBeam, selection BeamSelection) ([]BeamCandidate, error) {
+	constraints, err := selection.constraints()
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []BeamCandidate
+	for _, beam := range beams {
+		v := reflect.ValueOf(beam)
+		governing := GoverningConstraint{Utilisation: -1}
+		ok := true
+		for _, c := range constraints {
+			value := v.Field(c.column.Index).Float()
+			var utilisation float64
+			switch c.bound {
+			case "min":
+				if value < c.limit {
+					ok = false
+				} else if c.limit > 0 {
+					utilisation = c.limit / value
+				}
+			case "max":
+				if value > c.limit {
+					ok = false
+				} else {
+					utilisation = value / c.limit
+				}
+			}
+			if !ok {
+				break
+			}
+			if utilisation > governing.Utilisation {
+				governing = GoverningConstraint{Field: c.field, Bound: c.bound, Limit: c.limit, Value: value, Utilisation: utilisation}
+			}
+		}
+		if ok {
+			candidates = append(candidates, BeamCandidate{Beam: beam, Governing: governing})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].Beam, candidates[j].Beam
+		if a.MassPerMetre != b.MassPerMetre {
+			return a.MassPerMetre < b.MassPerMetre
+		}
+		return a.SectionDesignation < b.SectionDesignation
+	})
+	if selection.Limit > 0 && len(candidates) > selection.Limit {
+		candidates = candidates[:selection.Limit]
+	}
+	return candidates, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import "testing"
+
+// Simply supported spans in S275 (fy = 275 N/mm², E = 210 kN/mm²) with a
+// deflection limit of L/360 under the serviceability load, worked by hand:
+//
+//	6 m, wEd = 40 kN/m, w = 20 kN/m: MEd = 180 kNm so Wpl,y ≥ 654.5 cm³;
+//	  Iy ≥ 5wL⁴/(384E·L/360) = 9643 cm⁴. UB406x140x39 (724 cm³, 12500 cm⁴)
+//	  is the lightest section with both, and bending governs at 0.904.
+//	9 m, wEd = 20 kN/m, w = 12 kN/m: MEd = 202.5 kNm so Wpl,y ≥ 736.4 cm³;
+//	  Iy ≥ 19527 cm⁴. UB406x140x46 has the modulus but not the stiffness,
+//	  so UB457x152x52 (1100 cm³, 21400 cm⁴) is chosen and deflection
+//	  governs at 0.912.
+//	The 6 m case with 355 mm of headroom rules out UB406x140x39; UB356x127x39
+//	  (659 cm³, 10200 cm⁴, 353.4 mm deep) is chosen and depth governs at 0.995.
+func TestSelectBeamsLoadCases(t *testing.T) {
+	beams := testSeedBeams(t)
+	tests := []struct {
+		name        string
+		selection   BeamSelection
+		designation string
+		governing   GoverningConstraint
+	}{
+		{
+			"6 m span, bending governs",
+			BeamSelection{Minimums: map[string]float64{"plastic_modulus_axis_y": 654.5, "second_moment_of_area_axis_y": 9643}},
+			"UB406x140x39",
+			GoverningConstraint{Field: "plastic_modulus_axis_y", Bound: "min", Limit: 654.5, Value: 724, Utilisation: 654.5 / 724},
+		},
+		{
+			"9 m span, deflection governs",
+			BeamSelection{Minimums: map[string]float64{"plastic_modulus_axis_y": 736.4, "second_moment_of_area_axis_y": 19527}},
+			"UB457x152x52",
+			GoverningConstraint{Field: "second_moment_of_area_axis_y", Bound: "min", Limit: 19527, Value: 21400, Utilisation: 19527.0 / 21400},
+		},
+		{
+			"6 m span under 355 mm headroom, depth governs",
+			BeamSelection{
+				Minimums: map[string]float64{"plastic_modulus_axis_y": 654.5, "second_moment_of_area_axis_y": 9643},
+				Maximums: map[string]float64{"depth_of_section": 355},
+			},
+			"UB356x127x39",
+			GoverningConstraint{Field: "depth_of_section", Bound: "max", Limit: 355, Value: 353.4, Utilisation: 353.4 / 355},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates, err := SelectBeams(beams, tt.selection)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(candidates) == 0 {
+				t.Fatal("no section satisfies the selection")
+			}
+			best := candidates[0]
+			if best.Beam.SectionDesignation != tt.designation {
+				t.Errorf("chose %s, want %s", best.Beam.SectionDesignation, tt.designation)
+			}
+			if best.Governing != tt.governing {
+				t.Errorf("governed by %+v, want %+v", best.Governing, tt.governing)
+			}
+			for i, candidate := range candidates {
+				if candidate.Governing.Utilisation > 1 {
+					t.Errorf("%s utilised at %g", candidate.Beam.SectionDesignation, candidate.Governing.Utilisation)
+				}
+				if i > 0 && candidate.Beam.MassPerMetre < candidates[i-1].Beam.MassPerMetre {
+					t.Errorf("%s listed after the heavier %s", candidate.Beam.SectionDesignation, candidates[i-1].Beam.SectionDesignation)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectBeamsLimit(t *testing.T) {
+	selection := BeamSelection{Minimums: map[string]float64{"plastic_modulus_axis_y": 654.5}, Limit: 2}
+	candidates, err := SelectBeams(testSeedBeams(t), selection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 2 || candidates[0].Beam.SectionDesignation != "UB406x140x39" || candidates[1].Beam.SectionDesignation != "UB356x127x39" {
+		t.Errorf("got %d candidates, want UB406x140x39 then UB356x127x39", len(candidates))
+	}
+}
+
+func TestSelectBeamsRejectsBadSelection(t *testing.T) {
+	for name, selection := range map[string]BeamSelection{
+		"no constraints":   {},
+		"negative limit":   {Minimums: map[string]float64{"mass_per_metre": 1}, Limit: -1},
+		"unknown field":    {Minimums: map[string]float64{"strength": 1}},
+		"text field":       {Minimums: map[string]float64{"section_designation": 1}},
+		"negative minimum": {Minimums: map[string]float64{"plastic_modulus_axis_y": -1}},
+		"zero maximum":     {Maximums: map[string]float64{"depth_of_section": 0}},
+	} {
+		if _, err := SelectBeams(testSeedBeams(t), selection); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// BeamService implements the beam write operations behind both the REST
+// handlers and the gRPC server, so the two surfaces share one code path.
+// Every successful write is published to the event log.
+type BeamService struct {
+	repo   BeamRepository
+	events *BeamEventLog
+	// mu serialises writes so events are published in the order the
+	// repository applied them
+	mu sync.Mutex
+}
+
+// NewBeamService returns a service writing to repo and publishing to events
+func NewBeamService(repo BeamRepository, events *BeamEventLog) *BeamService {
+	return &BeamService{repo: repo, events: events}
+}
+
+// CreateBeam validates beam and adds it to the catalogue, returning it as
+// stored. It returns a *ValidationError for an invalid beam and
+// ErrBeamExists if the designation is taken.
+func (s *BeamService) CreateBeam(beam SteelBeam) (SteelBeam, error) {
+	if err := ValidateBeam(beam); err != nil {
+		return SteelBeam{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.repo.Create(beam); err != nil {
+		return SteelBeam{}, err
+	}
+	s.events.Publish(BeamEvent{Type: BeamCreated, SectionDesignation: beam.SectionDesignation, Beam: &beam})
+	return beam, nil
+}
+
+// UpdateBeam validates beam and replaces the beam with the given section
+// designation, returning the replacement. The replacement keeps the
+// designation: an empty one is filled in and a different one is rejected.
+// It returns a *ValidationError for an invalid beam and ErrBeamNotFound if
+// no beam matches.
+func (s *BeamService) UpdateBeam(sectionDesignation string, beam SteelBeam) (SteelBeam, error) {
+	if beam.SectionDesignation == "" {
+		// Fiber route parameters alias the request buffer; the beam outlives it
+		beam.SectionDesignation = strings.Clone(sectionDesignation)
+	}
+	if beam.SectionDesignation != sectionDesignation {
+		return SteelBeam{}, &ValidationError{Errors: []FieldError{{
+			Field:   "section_designation",
+			Message: fmt.Sprintf("must match the designation being updated (%s)", sectionDesignation),
+		}}}
+	}
+	if err := ValidateBeam(beam); err != nil {
+		return SteelBeam{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.repo.Update(sectionDesignation, beam); err != nil {
+		return SteelBeam{}, err
+	}
+	s.events.Publish(BeamEvent{Type: BeamUpdated, SectionDesignation: beam.SectionDesignation, Beam: &beam})
+	return beam, nil
+}
+
+// DeleteBeam removes the beam with the given section designation. It
+// returns ErrBeamNotFound if no beam matches.
+func (s *BeamService) DeleteBeam(sectionDesignation string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.repo.Delete(sectionDesignation); err != nil {
+		return err
+	}
+	s.events.Publish(BeamEvent{Type: BeamDeleted, SectionDesignation: strings.Clone(sectionDesignation)})
+	return nil
+}
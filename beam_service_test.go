@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Events outlive the request that published them, so the designations they
+// carry must not alias Fiber's reused request buffers.
+func TestBeamEventsKeepRouteParametersAfterRequest(t *testing.T) {
+	seed, err := LoadDefaultBeams()
+	if err != nil {
+		t.Fatalf("LoadDefaultBeams: %v", err)
+	}
+	first, second := seed[0], seed[1]
+	first.SectionDesignation = "UB1x1"
+	second.SectionDesignation = "UB2x2"
+
+	repo := NewMemoryBeamRepository([]SteelBeam{first, second})
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	_, published, cancel, err := events.Subscribe(0)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer cancel()
+	handlers := &httpHandlers{repo: repo, beams: NewBeamService(repo, events)}
+	app := fiber.New()
+	app.Get("/beams/:sectionDesignation", handlers.getBeam)
+	app.Put("/beams/:sectionDesignation", handlers.updateBeam)
+	app.Delete("/beams/:sectionDesignation", handlers.deleteBeam)
+
+	// The replacement takes its designation from the route
+	replacement := first
+	replacement.SectionDesignation = ""
+	body, err := json.Marshal(replacement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := []struct {
+		method, target string
+		body           []byte
+		status         int
+	}{
+		{http.MethodPut, "/beams/UB1x1", body, fiber.StatusOK},
+		{http.MethodDelete, "/beams/UB2x2", nil, fiber.StatusNoContent},
+		// Same-length paths overwrite the buffers the earlier requests used
+		{http.MethodGet, "/beams/UB9x9", nil, 0},
+		{http.MethodGet, "/beams/UB8x8", nil, 0},
+	}
+	for _, r := range requests {
+		req := httptest.NewRequest(r.method, r.target, bytes.NewReader(r.body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("%s %s: %v", r.method, r.target, err)
+		}
+		resp.Body.Close()
+		if r.status != 0 && resp.StatusCode != r.status {
+			t.Fatalf("%s %s: status %d, want %d", r.method, r.target, resp.StatusCode, r.status)
+		}
+	}
+
+	updated, deleted := <-published, <-published
+	if updated.SectionDesignation != "UB1x1" || updated.Beam.SectionDesignation != "UB1x1" {
+		t.Errorf("update event names %q with beam %q, want UB1x1", updated.SectionDesignation, updated.Beam.SectionDesignation)
+	}
+	if deleted.SectionDesignation != "UB2x2" {
+		t.Errorf("delete event names %q, want UB2x2", deleted.SectionDesignation)
+	}
+}
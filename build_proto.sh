@@ -33,19 +33,18 @@ fi
 
 # Generate Go files from protobuf
 echo "🚀 Generating Go protobuf files..."
+# steelbeam.proto is the original package; v1/steelbeam.proto imports it
 protoc \
-    --go_out=. \
+    -I proto_src \
+    --go_out=proto \
     --go_opt=paths=source_relative \
-    --go-grpc_out=. \
+    --go-grpc_out=proto \
     --go-grpc_opt=paths=source_relative \
-    proto_src/steelbeam.proto
-
-# Move generated files to proto directory
-mv proto_src/*.pb.go proto/ 2>/dev/null || true
+    steelbeam.proto v1/steelbeam.proto
 
 echo "✅ Protobuf files generated successfully!"
 echo "📁 Files created:"
-ls -la proto/*.pb.go
+ls -la proto/*.pb.go proto/v1/*.pb.go
 
 echo ""
 echo "🎯 Ready for deployment! The Go API service now has all required protobuf files."
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// gravity converts kg/m to N/m for self-weight
+const gravity = 9.81
+
+// Default partial factors for EN 1990 expression 6.10 and the imposed load
+// deflection limit used when a design request leaves them unset
+const (
+	defaultGammaG          = 1.35
+	defaultGammaQ          = 1.5
+	defaultDeflectionLimit = 360
+)
+
+// DesignLoad is a characteristic load split into permanent and variable parts
+type DesignLoad struct {
+	Permanent float64 `json:"permanent"`
+	Variable  float64 `json:"variable"`
+}
+
+// PointLoad is a characteristic point load in kN at Position metres from the left support
+type PointLoad struct {
+	Position  float64 `json:"position"`
+	Permanent float64 `json:"permanent"`
+	Variable  float64 `json:"variable"`
+}
+
+// LoadFactors are the ULS partial factors applied to permanent and variable loads
+type LoadFactors struct {
+	Permanent float64 `json:"permanent"`
+	Variable  float64 `json:"variable"`
+}
+
+// LateralRestraint describes how the compression flange is restrained.
+// Continuous restraint removes the LTB check; otherwise the supports and any
+// intermediate Positions (metres from the left support) bound LTB segments.
+type LateralRestraint struct {
+	Continuous bool      `json:"continuous"`
+	Positions  []float64 `json:"positions"`
+}
+
+// SimplySupportedDesign is a simply-supported beam design problem. Span and
+// positions are in metres, UDLs in kN/m and point loads in kN. Every beam in
+// the catalogue is checked unless Sections names specific candidates.
+type SimplySupportedDesign struct {
+	Span             float64          `json:"span"`
+	SteelGrade       string           `json:"steel_grade"`
+	UDL              DesignLoad       `json:"udl"`
+	PointLoads       []PointLoad      `json:"point_loads"`
+	LoadFactors      LoadFactors      `json:"load_factors"`
+	LateralRestraint LateralRestraint `json:"lateral_restraint"`
+	LoadPosition     LoadPosition     `json:"load_position"`
+	DeflectionLimit  float64          `json:"deflection_limit"`
+	IgnoreSelfWeight bool             `json:"ignore_self_weight"`
+	Sections         []string         `json:"sections"`
+	OnlyPassing      bool             `json:"only_passing"`
+	Limit            int              `json:"limit"`
+}
+
+// DesignCheck is one limit state check; Utilisation is DesignValue/Resistance
+type DesignCheck struct {
+	DesignValue float64 `json:"design_value"`
+	Resistance  float64 `json:"resistance"`
+	Utilisation float64 `json:"utilisation"`
+}
+
+// LTBCheck is the lateral-torsional buckling check of the governing segment
+type LTBCheck struct {
+	DesignCheck
+	SegmentStart    float64 `json:"segment_start"`
+	SegmentEnd      float64 `json:"segment_end"`
+	EffectiveLength float64 `json:"effective_length"`
+	C1              float64 `json:"c1"`
+	C2              float64 `json:"c2"`
+}
+
+// SectionDesignResult is the outcome of checking one beam. Moments are in
+// kNm, shears in kN and deflections in mm. HighShear is set when the shear
+// where the moment is greatest exceeds 0.5 Vpl,Rd; the bending resistance
+// is then reduced by ShearReduction (ρ, EN 1993-1-1 6.2.8).
+type SectionDesignResult struct {
+	SectionDesignation string       `json:"section_designation"`
+	MassPerMetre       float64      `json:"mass_per_metre"`
+	SectionClass       int          `json:"section_class,omitempty"`
+	Passes             bool         `json:"passes"`
+	Governing          string       `json:"governing,omitempty"`
+	MaxUtilisation     float64      `json:"max_utilisation"`
+	Bending            *DesignCheck `json:"bending,omitempty"`
+	Shear              *DesignCheck `json:"shear,omitempty"`
+	ShearAtMaxMoment   float64      `json:"shear_at_max_moment"`
+	HighShear          bool         `json:"high_shear"`
+	ShearReduction     float64      `json:"shear_reduction"`
+	LTB                *LTBCheck    `json:"ltb,omitempty"`
+	Deflection         *DesignCheck `json:"deflection,omitempty"`
+	Error              string       `json:"error,omitempty"`
+}
+
+// withDefaults fills in default factors and validates the problem
+func (d SimplySupportedDesign) withDefaults() (SimplySupportedDesign, error) {
+	if d.Span <= 0 {
+		return d, errors.New("span must be positive")
+	}
+	if d.LoadFactors.Permanent == 0 {
+		d.LoadFactors.Permanent = defaultGammaG
+	}
+	if d.LoadFactors.Variable == 0 {
+		d.LoadFactors.Variable = defaultGammaQ
+	}
+	if d.LoadFactors.Permanent < 0 || d.LoadFactors.Variable < 0 {
+		return d, errors.New("load factors must not be negative")
+	}
+	if d.DeflectionLimit == 0 {
+		d.DeflectionLimit = defaultDeflectionLimit
+	}
+	if d.DeflectionLimit < 0 {
+		return d, errors.New("deflection_limit must be positive")
+	}
+	if d.UDL.Permanent < 0 || d.UDL.Variable < 0 {
+		return d, errors.New("udl must not be negative")
+	}
+	for i, p := range d.PointLoads {
+		if p.Position < 0 || p.Position > d.Span {
+			return d, fmt.Errorf("point_loads[%d].position must lie within the span", i)
+		}
+		if p.Permanent < 0 || p.Variable < 0 {
+			return d, fmt.Errorf("point_loads[%d] must not be negative", i)
+		}
+	}
+	for i, x := range d.LateralRestraint.Positions {
+		if x <= 0 || x >= d.Span {
+			return d, fmt.Errorf("lateral_restraint.positions[%d] must lie strictly within the span", i)
+		}
+	}
+	if d.Limit < 0 {
+		return d, errors.New("limit must not be negative")
+	}
+	switch d.LoadPosition {
+	case "":
+		d.LoadPosition = LoadAtShearCentre
+	case LoadAtShearCentre, LoadAtTopFlange, LoadAtBottomFlange:
+	default:
+		return d, fmt.Errorf("unknown load_position %q (expected %s, %s or %s)", d.LoadPosition, LoadAtShearCentre, LoadAtTopFlange, LoadAtBottomFlange)
+	}
+	return d, nil
+}
+
+// beamLoading is a factored load case on a simply-supported span, in N and mm
+type beamLoading struct {
+	span   float64
+	udl    float64
+	points []PointLoad // Permanent holds the combined load in N, Position in mm
+}
+
+// loading combines the design loads with the given factors. selfWeight is in kN/m.
+func (d SimplySupportedDesign) loading(gammaG, gammaQ, selfWeight float64) beamLoading {
+	l := beamLoading{
+		span: d.Span * 1e3,
+		udl:  gammaG*(d.UDL.Permanent+selfWeight) + gammaQ*d.UDL.Variable,
+	}
+	for _, p := range d.PointLoads {
+		l.points = append(l.points, PointLoad{
+			Position:  p.Position * 1e3,
+			Permanent: (gammaG*p.Permanent + gammaQ*p.Variable) * 1e3,
+		})
+	}
+	return l
+}
+
+func (l beamLoading) leftReaction() float64 {
+	r := l.udl * l.span / 2
+	for _, p := range l.points {
+		r += p.Permanent * (l.span - p.Position) / l.span
+	}
+	return r
+}
+
+func (l beamLoading) rightReaction() float64 {
+	r := l.udl * l.span / 2
+	for _, p := range l.points {
+		r += p.Permanent * p.Position / l.span
+	}
+	return r
+}
+
+// moment returns the sagging moment in Nmm at x mm from the left support
+func (l beamLoading) moment(x float64) float64 {
+	m := l.leftReaction()*x - l.udl*x*x/2
+	for _, p := range l.points {
+		if p.Position < x {
+			m -= p.Permanent * (x - p.Position)
+		}
+	}
+	return m
+}
+
+// shear returns the larger shear magnitude in N either side of x mm from
+// the left support, so a section under a point load takes the worse side
+func (l beamLoading) shear(x float64) float64 {
+	left := l.leftReaction() - l.udl*x
+	right := left
+	for _, p := range l.points {
+		switch {
+		case p.Position < x:
+			left -= p.Permanent
+			right -= p.Permanent
+		case p.Position == x:
+			right -= p.Permanent
+		}
+	}
+	return math.Max(math.Abs(left), math.Abs(right))
+}
+
+// maxMoment returns the largest moment magnitude between a and b, in mm
+func (l beamLoading) maxMoment(a, b float64) float64 {
+	_, m := l.maxMomentAt(a, b)
+	return m
+}
+
+// maxMomentAt returns where between a and b the moment magnitude is largest,
+// and that moment
+func (l beamLoading) maxMomentAt(a, b float64) (float64, float64) {
+	const samples = 200
+	at, m := a, 0.0
+	consider := func(x float64) {
+		if mx := math.Abs(l.moment(x)); mx > m {
+			at, m = x, mx
+		}
+	}
+	consider(a)
+	consider(b)
+	for i := 1; i < samples; i++ {
+		consider(a + (b-a)*float64(i)/samples)
+	}
+	for _, p := range l.points {
+		if p.Position > a && p.Position < b {
+			consider(p.Position)
+		}
+	}
+	return at, m
+}
+
+// maxDeflection returns the largest deflection in mm for flexural rigidity ei in Nmm²
+func (l beamLoading) maxDeflection(ei float64) float64 {
+	const samples = 200
+	span := l.span
+	var maxDelta float64
+	for i := 1; i < samples; i++ {
+		x := span * float64(i) / samples
+		delta := l.udl * x * (span*span*span - 2*span*x*x + x*x*x) / (24 * ei)
+		for _, p := range l.points {
+			a, b := p.Position, span-p.Position
+			xx := x
+			if xx > a {
+				// Mirror the beam so the standard x <= a expression applies
+				xx, a, b = span-x, b, a
+			}
+			delta += p.Permanent * b * xx * (span*span - b*b - xx*xx) / (6 * ei * span)
+		}
+		maxDelta = math.Max(maxDelta, delta)
+	}
+	return maxDelta
+}
+
+// momentGradientC1 estimates C1 for a segment from its moment shape using
+// the quarter-point expression 12.5Mmax/(2.5Mmax+3MA+4MB+3MC), capped at 2.5
+func (l beamLoading) momentGradientC1(a, b float64) float64 {
+	mMax := l.maxMoment(a, b)
+	if mMax == 0 {
+		return 1
+	}
+	mA := math.Abs(l.moment(a + (b-a)/4))
+	mB := math.Abs(l.moment(a + (b-a)/2))
+	mC := math.Abs(l.moment(a + 3*(b-a)/4))
+	return math.Min(12.5*mMax/(2.5*mMax+3*mA+4*mB+3*mC), 2.5)
+}
+
+// loadHeightC2 returns C2 for load applied away from the shear centre within
+// the segment a to b, in mm: 0.459 where the UDL gives most of the segment's
+// free moment and 0.553 where point loads do. These are the ENV 1993-1-1
+// Annex F (Table F.1.2) values for uniform and central point loading on a
+// simply-supported segment. It returns 0 if no load acts within the segment.
+func (l beamLoading) loadHeightC2(a, b float64) float64 {
+	length := b - a
+	udlMoment := l.udl * length * length / 8
+	var pointMoment float64
+	for _, p := range l.points {
+		if p.Position > a && p.Position < b {
+			x := p.Position - a
+			pointMoment += p.Permanent * x * (length - x) / length
+		}
+	}
+	switch {
+	case udlMoment == 0 && pointMoment == 0:
+		return 0
+	case pointMoment > udlMoment:
+		return 0.553
+	}
+	return 0.459
+}
+
+// CheckSimplySupportedBeam runs the ULS and SLS checks for one beam. Errors
+// that only affect this beam, such as a class 4 section, are reported in
+// the result rather than returned. d must have passed withDefaults.
+func CheckSimplySupportedBeam(beam SteelBeam, d SimplySupportedDesign) SectionDesignResult {
+	result := SectionDesignResult{
+		SectionDesignation: beam.SectionDesignation,
+		MassPerMetre:       beam.MassPerMetre,
+	}
+	fail := func(err error) SectionDesignResult {
+		result.Passes = false
+		result.Error = err.Error()
+		return result
+	}
+
+	resistance, err := CalculateBeamResistance(beam, d.SteelGrade)
+	if err != nil {
+		return fail(err)
+	}
+	if err := requireBeamProperties(beam, "second_moment_of_area_axis_y"); err != nil {
+		return fail(err)
+	}
+	result.SectionClass = resistance.SectionClass
+
+	var selfWeight float64
+	if !d.IgnoreSelfWeight {
+		selfWeight = beam.MassPerMetre * gravity / 1e3
+	}
+	uls := d.loading(d.LoadFactors.Permanent, d.LoadFactors.Variable, selfWeight)
+
+	xMax, mMax := uls.maxMomentAt(0, uls.span)
+	mEd := mMax / 1e6
+	vEd := math.Max(uls.leftReaction(), uls.rightReaction()) / 1e3
+	result.ShearAtMaxMoment = uls.shear(xMax) / 1e3
+	result.HighShear = result.ShearAtMaxMoment > 0.5*resistance.ShearResistance
+	mRd, rho := ShearReducedMomentResistance(beam, resistance, result.ShearAtMaxMoment)
+	result.ShearReduction = rho
+	result.Bending = &DesignCheck{DesignValue: mEd, Resistance: mRd, Utilisation: mEd / mRd}
+	result.Shear = &DesignCheck{DesignValue: vEd, Resistance: resistance.ShearResistance, Utilisation: vEd / resistance.ShearResistance}
+
+	if !d.LateralRestraint.Continuous {
+		bounds := append([]float64{0}, d.LateralRestraint.Positions...)
+		bounds = append(bounds, d.Span)
+		sort.Float64s(bounds)
+
+		for i := 0; i+1 < len(bounds); i++ {
+			a, b := bounds[i], bounds[i+1]
+			if b-a <= 0 {
+				continue
+			}
+			segmentMoment := uls.maxMoment(a*1e3, b*1e3) / 1e6
+			c1 := uls.momentGradientC1(a*1e3, b*1e3)
+			effectiveLength := b - a
+			// Load height enters Mcr through C2·zg; a segment with no load
+			// within it has no load height effect
+			position, c2 := d.LoadPosition, 0.0
+			if position != LoadAtShearCentre {
+				c2 = uls.loadHeightC2(a*1e3, b*1e3)
+				if c2 == 0 {
+					position = LoadAtShearCentre
+				}
+			}
+
+			ltb, err := CalculateLTBResistance(beam, LTBInput{
+				SteelGrade:      d.SteelGrade,
+				EffectiveLength: effectiveLength,
+				LoadPosition:    position,
+				C1:              c1,
+				C2:              c2,
+			})
+			if err != nil {
+				return fail(err)
+			}
+			utilisation := segmentMoment / ltb.BucklingResistance
+			if result.LTB == nil || utilisation > result.LTB.Utilisation {
+				result.LTB = &LTBCheck{
+					DesignCheck:     DesignCheck{DesignValue: segmentMoment, Resistance: ltb.BucklingResistance, Utilisation: utilisation},
+					SegmentStart:    a,
+					SegmentEnd:      b,
+					EffectiveLength: effectiveLength,
+					C1:              c1,
+					C2:              c2,
+				}
+			}
+		}
+	}
+
+	// SLS deflection under unfactored variable load
+	sls := d.loading(0, 1, 0)
+	ei := youngsModulus * beam.SecondMomentOfAreaAxisY * 1e4
+	allowable := d.Span * 1e3 / d.DeflectionLimit
+	deflection := sls.maxDeflection(ei)
+	result.Deflection = &DesignCheck{DesignValue: deflection, Resistance: allowable, Utilisation: deflection / allowable}
+
+	checks := []struct {
+		name  string
+		check *DesignCheck
+	}{
+		{"bending", result.Bending},
+		{"shear", result.Shear},
+		{"deflection", result.Deflection},
+	}
+	if result.LTB != nil {
+		checks = append(checks, struct {
+			name  string
+			check *DesignCheck
+		}{"ltb", &result.LTB.DesignCheck})
+	}
+	for _, c := range checks {
+		if c.check.Utilisation > result.MaxUtilisation {
+			result.MaxUtilisation = c.check.Utilisation
+			result.Governing = c.name
+		}
+	}
+	result.Passes = result.MaxUtilisation <= 1
+	return result
+}
+
+// DesignSimplySupported checks each candidate beam, lightest first, and
+// passes every result to emit until it returns an error
+func DesignSimplySupported(beams []SteelBeam, d SimplySupportedDesign, emit func(SectionDesignResult) error) error {
+	d, err := d.withDefaults()
+	if err != nil {
+		return err
+	}
+	if _, err := LookupMaterial(d.SteelGrade); err != nil {
+		return err
+	}
+
+	candidates := beams
+	if len(d.Sections) > 0 {
+		byDesignation := map[string]SteelBeam{}
+		for _, beam := range beams {
+			byDesignation[beam.SectionDesignation] = beam
+		}
+		candidates = nil
+		for _, designation := range d.Sections {
+			beam, ok := byDesignation[designation]
+			if !ok {
+				return fmt.Errorf("beam %s not found", designation)
+			}
+			candidates = append(candidates, beam)
+		}
+	}
+
+	sorted := make([]SteelBeam, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].MassPerMetre != sorted[j].MassPerMetre {
+			return sorted[i].MassPerMetre < sorted[j].MassPerMetre
+		}
+		return sorted[i].SectionDesignation < sorted[j].SectionDesignation
+	})
+
+	emitted := 0
+	for _, beam := range sorted {
+		result := CheckSimplySupportedBeam(beam, d)
+		if d.OnlyPassing && !result.Passes {
+			continue
+		}
+		if err := emit(result); err != nil {
+			return err
+		}
+		emitted++
+		if d.Limit > 0 && emitted >= d.Limit {
+			break
+		}
+	}
+	return nil
+}
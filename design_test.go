@@ -0,0 +1,152 @@
+package main
+
+import "testing"
+
+// C2 takes the ENV 1993-1-1 Annex F values: 0.459 for uniform load and
+// 0.553 for central point load
+func TestLoadHeightC2(t *testing.T) {
+	tests := []struct {
+		name    string
+		loading beamLoading
+		a, b    float64
+		want    float64
+	}{
+		{"udl", beamLoading{span: 6000, udl: 10}, 0, 6000, 0.459},
+		{"central point load", beamLoading{span: 6000, points: []PointLoad{{Position: 3000, Permanent: 50e3}}}, 0, 6000, 0.553},
+		{"udl dominates", beamLoading{span: 6000, udl: 10, points: []PointLoad{{Position: 3000, Permanent: 10e3}}}, 0, 6000, 0.459},
+		{"point load dominates", beamLoading{span: 6000, udl: 10, points: []PointLoad{{Position: 3000, Permanent: 100e3}}}, 0, 6000, 0.553},
+		{"point load outside segment", beamLoading{span: 6000, points: []PointLoad{{Position: 1000, Permanent: 50e3}}}, 2000, 6000, 0},
+		{"no load", beamLoading{span: 6000}, 0, 6000, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.loading.loadHeightC2(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: C2 = %g, want %g", tt.name, got, tt.want)
+		}
+	}
+}
+
+// My,V,Rd per 6.2.8 for UB 406x178x74 in S355: the web area hw·tw = 3617.6
+// mm² gives a plastic web modulus of 344 398 mm³, worked by hand
+func TestShearReducedMomentResistance(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	r, err := CalculateBeamResistance(beam, "S355")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		shareOfVpl float64
+		rho        float64
+		moment     float64
+	}{
+		{0.3, 0, 532.5},
+		{0.5, 0, 532.5},
+		{0.75, 0.25, 501.93},
+		{1, 1, 410.24},
+	}
+	for _, tt := range tests {
+		moment, rho := ShearReducedMomentResistance(beam, r, tt.shareOfVpl*r.ShearResistance)
+		assertWithin(t, "ρ", rho, tt.rho, 1e-9)
+		assertWithin(t, "My,V,Rd", moment, tt.moment, 0.01)
+	}
+}
+
+// A 6 m span carrying 10 kN/m permanent and 15 kN/m variable load with the
+// compression flange fully restrained, checked by hand
+func TestCheckSimplySupportedBeamUDL(t *testing.T) {
+	d, err := SimplySupportedDesign{
+		Span:             6,
+		SteelGrade:       "S355",
+		UDL:              DesignLoad{Permanent: 10, Variable: 15},
+		LateralRestraint: LateralRestraint{Continuous: true},
+		IgnoreSelfWeight: true,
+	}.withDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := CheckSimplySupportedBeam(catalogueBeam(t, "UB406x178x74"), d)
+	if result.Error != "" {
+		t.Fatal(result.Error)
+	}
+
+	// wEd = 1.35·10 + 1.5·15 = 36 kN/m, MEd = wL²/8, VEd = wL/2, and
+	// δ = 5wL⁴/384EI under the 15 kN/m variable load
+	assertWithin(t, "MEd", result.Bending.DesignValue, 162, 1e-6)
+	assertWithin(t, "Mc,Rd", result.Bending.Resistance, 532.5, 1e-9)
+	assertWithin(t, "VEd", result.Shear.DesignValue, 108, 1e-6)
+	assertWithin(t, "δ", result.Deflection.DesignValue, 4.415, 0.001)
+	assertWithin(t, "δ limit", result.Deflection.Resistance, 6000.0/360, 1e-9)
+	if result.HighShear || result.ShearReduction != 0 {
+		t.Errorf("high shear %v (ρ %g) at midspan, want none", result.HighShear, result.ShearReduction)
+	}
+	if result.LTB != nil {
+		t.Errorf("continuous restraint should skip the LTB check")
+	}
+	if !result.Passes || result.Governing != "bending" {
+		t.Errorf("passes %v governed by %s, want a pass governed by bending", result.Passes, result.Governing)
+	}
+}
+
+// A central point load puts the full reaction at the section of maximum
+// moment, so the bending resistance must take the 6.2.8 reduction
+func TestCheckSimplySupportedBeamHighShear(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	r, err := CalculateBeamResistance(beam, "S355")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Factored point load giving VEd = 0.75 Vpl,Rd
+	load := 2 * 0.75 * r.ShearResistance / defaultGammaG
+	d, err := SimplySupportedDesign{
+		Span:             2,
+		SteelGrade:       "S355",
+		PointLoads:       []PointLoad{{Position: 1, Permanent: load}},
+		LateralRestraint: LateralRestraint{Continuous: true},
+		IgnoreSelfWeight: true,
+	}.withDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := CheckSimplySupportedBeam(beam, d)
+	if result.Error != "" {
+		t.Fatal(result.Error)
+	}
+	if !result.HighShear {
+		t.Fatal("expected high shear at the point load")
+	}
+	assertWithin(t, "VEd at max moment", result.ShearAtMaxMoment, 0.75*r.ShearResistance, 1e-6)
+	assertWithin(t, "ρ", result.ShearReduction, 0.25, 1e-9)
+	assertWithin(t, "My,V,Rd", result.Bending.Resistance, 501.93, 0.01)
+}
+
+// Load above the shear centre lowers Mcr, so an unrestrained span resists
+// less with top-flange loading than with load at the shear centre
+func TestCheckSimplySupportedBeamTopFlangeLoad(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	check := func(position LoadPosition) *LTBCheck {
+		t.Helper()
+		d, err := SimplySupportedDesign{
+			Span:         6,
+			SteelGrade:   "S355",
+			UDL:          DesignLoad{Permanent: 10, Variable: 10},
+			LoadPosition: position,
+		}.withDefaults()
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := CheckSimplySupportedBeam(beam, d)
+		if result.Error != "" || result.LTB == nil {
+			t.Fatalf("%s: no LTB check (%s)", position, result.Error)
+		}
+		return result.LTB
+	}
+
+	centre, top := check(LoadAtShearCentre), check(LoadAtTopFlange)
+	if centre.C2 != 0 || top.C2 != 0.459 {
+		t.Errorf("C2 = %g at the shear centre and %g on the top flange, want 0 and 0.459", centre.C2, top.C2)
+	}
+	// A UDL gives C1 = 12.5/(2.5 + 3·0.75 + 4 + 3·0.75) = 1.136 from the quarter points
+	assertWithin(t, "C1", top.C1, 12.5/11, 1e-9)
+	if top.Resistance >= centre.Resistance {
+		t.Errorf("top-flange Mb,Rd %.1f should be below the shear-centre %.1f", top.Resistance, centre.Resistance)
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// gammaM0 is the partial factor for cross-section resistance (UK National Annex to EN 1993-1-1)
+const gammaM0 = 1.0
+
+// shearAreaFactorEta is η from EN 1993-1-5 5.1, taken as 1.0 per the UK National Annex
+const shearAreaFactorEta = 1.0
+
+// BeamResistance is the EN 1993-1-1 cross-section resistance of a beam
+// bending about its major axis. Moments are in kNm, forces in kN and
+// strengths in N/mm².
+type BeamResistance struct {
+	SectionDesignation         string  `json:"section_designation"`
+	SteelGrade                 string  `json:"steel_grade"`
+	YieldStrength              float64 `json:"yield_strength"`
+	Epsilon                    float64 `json:"epsilon"`
+	WebClass                   int     `json:"web_class"`
+	FlangeClass                int     `json:"flange_class"`
+	SectionClass               int     `json:"section_class"`
+	MomentResistance           float64 `json:"moment_resistance"`
+	ShearArea                  float64 `json:"shear_area"`
+	ShearResistance            float64 `json:"shear_resistance"`
+	ShearBucklingCheckRequired bool    `json:"shear_buckling_check_required"`
+	GammaM0                    float64 `json:"gamma_m0"`
+}
+
+// classifyElement returns the class (1-3, or 4 if slender) of a plate with
+// slenderness ratio against the class 1, 2 and 3 limits from Table 5.2
+func classifyElement(ratio float64, limits [3]float64) int {
+	for i, limit := range limits {
+		if ratio <= limit {
+			return i + 1
+		}
+	}
+	return 4
+}
+
+// CalculateBeamResistance classifies beam per EN 1993-1-1 Table 5.2 for
+// pure bending about y-y and returns Mc,Rd (6.2.5) and Vc,Rd (6.2.6)
+func CalculateBeamResistance(beam SteelBeam, grade string) (BeamResistance, error) {
+	if err := requireBeamProperties(beam,
+		"depth_of_section", "width_of_section", "thickness_web", "thickness_flange",
+		"ratios_for_local_buckling_web", "ratios_for_local_buckling_flange",
+		"elastic_modulus_axis_y", "plastic_modulus_axis_y", "area_of_section"); err != nil {
+		return BeamResistance{}, err
+	}
+
+	fy, err := yieldStrength(grade, math.Max(beam.ThicknessFlange, beam.ThicknessWeb))
+	if err != nil {
+		return BeamResistance{}, err
+	}
+	epsilon := math.Sqrt(235 / fy)
+
+	r := BeamResistance{
+		SectionDesignation: beam.SectionDesignation,
+		SteelGrade:         strings.ToUpper(grade),
+		YieldStrength:      fy,
+		Epsilon:            epsilon,
+		GammaM0:            gammaM0,
+	}
+
+	// Web: internal part in bending; flange: outstand in compression
+	r.WebClass = classifyElement(beam.RatiosForLocalBucklingWeb, [3]float64{72 * epsilon, 83 * epsilon, 124 * epsilon})
+	r.FlangeClass = classifyElement(beam.RatiosForLocalBucklingFlange, [3]float64{9 * epsilon, 10 * epsilon, 14 * epsilon})
+	r.SectionClass = max(r.WebClass, r.FlangeClass)
+
+	switch r.SectionClass {
+	case 1, 2:
+		r.MomentResistance = beam.PlasticModulusAxisY * fy / gammaM0 / 1e3
+	case 3:
+		r.MomentResistance = beam.ElasticModulusAxisY * fy / gammaM0 / 1e3
+	default:
+		return BeamResistance{}, fmt.Errorf("%s is class 4 in %s; effective section properties are not supported", beam.SectionDesignation, r.SteelGrade)
+	}
+
+	// Shear area for rolled I-sections loaded parallel to the web, 6.2.6(3)(a), in mm²
+	h, b, tw, tf, radius := beam.DepthOfSection, beam.WidthOfSection, beam.ThicknessWeb, beam.ThicknessFlange, beam.RootRadius
+	hw := h - 2*tf
+	area := beam.AreaOfSection * 100
+	r.ShearArea = math.Max(area-2*b*tf+(tw+2*radius)*tf, shearAreaFactorEta*hw*tw)
+	r.ShearResistance = r.ShearArea * fy / math.Sqrt(3) / gammaM0 / 1e3
+	r.ShearBucklingCheckRequired = hw/tw > 72*epsilon/shearAreaFactorEta
+
+	return r, nil
+}
+
+// ShearReducedMomentResistance returns the moment resistance in kNm of a
+// section carrying vEd kN per EN 1993-1-1 6.2.8, with the reduction factor
+// ρ. Up to 0.5 Vpl,Rd there is no reduction and ρ is zero. Above it, the web
+// area hw·tw takes the reduced yield strength (1−ρ)fy: 6.2.8(5) for class 1
+// and 2 sections, and the web's elastic modulus for class 3.
+func ShearReducedMomentResistance(beam SteelBeam, r BeamResistance, vEd float64) (float64, float64) {
+	if vEd <= 0.5*r.ShearResistance {
+		return r.MomentResistance, 0
+	}
+	rho := math.Min(math.Pow(2*vEd/r.ShearResistance-1, 2), 1)
+
+	tw := beam.ThicknessWeb
+	aw := (beam.DepthOfSection - 2*beam.ThicknessFlange) * tw
+	webModulus := aw * aw / (4 * tw)
+	if r.SectionClass == 3 {
+		webModulus = aw * aw / (6 * tw)
+	}
+	reduced := r.MomentResistance - rho*webModulus*r.YieldStrength/gammaM0/1e6
+	return math.Max(math.Min(reduced, r.MomentResistance), 0), rho
+}
+
+// requireBeamProperties reports any of the named SteelBeam fields that are not positive
+func requireBeamProperties(beam SteelBeam, fields ...string) error {
+	var missing []string
+	for _, field := range fields {
+		column, ok := beamColumnByName(field)
+		if !ok {
+			return fmt.Errorf("unknown beam field %q", field)
+		}
+		if beamFieldValue(beam, column) <= 0 {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.New("section is missing properties required for the calculation: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+const (
+	// gammaM1 is the partial factor for member instability (UK National Annex)
+	gammaM1 = 1.0
+	// lambdaLT0 and betaLT are the 6.3.2.3 parameters for rolled sections
+	lambdaLT0 = 0.4
+	betaLT    = 0.75
+)
+
+// LoadPosition describes where transverse load is applied relative to the shear centre
+type LoadPosition string
+
+const (
+	LoadAtShearCentre  LoadPosition = "shear_centre"
+	LoadAtTopFlange    LoadPosition = "top_flange"
+	LoadAtBottomFlange LoadPosition = "bottom_flange"
+)
+
+// LTBInput describes the restraint and loading of a beam segment for
+// lateral-torsional buckling. EffectiveLength is in metres; C1 defaults to
+// 1.0 (uniform moment) and C2 is required when load is not applied at the
+// shear centre.
+type LTBInput struct {
+	SteelGrade      string       `json:"steel_grade"`
+	EffectiveLength float64      `json:"effective_length"`
+	LoadPosition    LoadPosition `json:"load_position"`
+	C1              float64      `json:"c1"`
+	C2              float64      `json:"c2"`
+}
+
+// LTBResistance is the lateral-torsional buckling resistance of a beam
+// segment per EN 1993-1-1 6.3.2. Moments are in kNm.
+type LTBResistance struct {
+	SectionDesignation   string  `json:"section_designation"`
+	SteelGrade           string  `json:"steel_grade"`
+	YieldStrength        float64 `json:"yield_strength"`
+	SectionClass         int     `json:"section_class"`
+	EffectiveLength      float64 `json:"effective_length"`
+	LoadPosition         string  `json:"load_position"`
+	C1                   float64 `json:"c1"`
+	C2                   float64 `json:"c2"`
+	CriticalMoment       float64 `json:"critical_moment"`
+	Slenderness          float64 `json:"slenderness"`
+	BucklingCurve        string  `json:"buckling_curve"`
+	ImperfectionFactor   float64 `json:"imperfection_factor"`
+	ReductionFactor      float64 `json:"reduction_factor"`
+	ModificationFactor   float64 `json:"modification_factor"`
+	ModifiedReduction    float64 `json:"modified_reduction_factor"`
+	BucklingResistance   float64 `json:"buckling_resistance"`
+	CrossSectionMomentRd float64 `json:"cross_section_moment_resistance"`
+	GammaM1              float64 `json:"gamma_m1"`
+}
+
+// ltbBucklingCurve returns the curve and αLT for rolled I-sections from UK NA Table NA.5
+func ltbBucklingCurve(beam SteelBeam) (string, float64) {
+	ratio := beam.DepthOfSection / beam.WidthOfSection
+	switch {
+	case ratio <= 2:
+		return "b", 0.34
+	case ratio <= 3.1:
+		return "c", 0.49
+	default:
+		return "d", 0.76
+	}
+}
+
+// CriticalMoment returns the elastic critical moment Mcr in kNm from the
+// general formula for doubly symmetric sections (SCI SN003), with the
+// warping effective length factor taken equal to the lateral one
+func CriticalMoment(beam SteelBeam, effectiveLength, c1, c2 float64, position LoadPosition) (float64, error) {
+	var zg float64
+	switch position {
+	case LoadAtShearCentre, "":
+	case LoadAtTopFlange:
+		zg = beam.DepthOfSection / 2
+	case LoadAtBottomFlange:
+		zg = -beam.DepthOfSection / 2
+	default:
+		return 0, fmt.Errorf("unknown load_position %q (expected %s, %s or %s)", position, LoadAtShearCentre, LoadAtTopFlange, LoadAtBottomFlange)
+	}
+
+	length := effectiveLength * 1e3
+	iz := beam.SecondMomentOfAreaAxisZ * 1e4
+	iw := beam.WarpingConstant * 1e12
+	it := beam.TorsionalConstant * 1e4
+
+	euler := math.Pi * math.Pi * youngsModulus * iz / (length * length)
+	root := math.Sqrt(iw/iz + length*length*shearModulus*it/(math.Pi*math.Pi*youngsModulus*iz) + (c2*zg)*(c2*zg))
+	return c1 * euler * (root - c2*zg) / 1e6, nil
+}
+
+// CalculateLTBResistance returns Mb,Rd for a beam segment using the 6.3.2.3
+// method for rolled sections with the f modification factor, taking
+// kc = 1/√C1 as permitted by the UK National Annex
+func CalculateLTBResistance(beam SteelBeam, in LTBInput) (LTBResistance, error) {
+	if in.EffectiveLength <= 0 {
+		return LTBResistance{}, errors.New("effective_length must be positive")
+	}
+	if in.C1 == 0 {
+		in.C1 = 1.0
+	}
+	if in.C1 < 1 {
+		return LTBResistance{}, errors.New("c1 must be at least 1.0")
+	}
+	if in.LoadPosition == "" {
+		in.LoadPosition = LoadAtShearCentre
+	}
+	if in.LoadPosition != LoadAtShearCentre && in.C2 <= 0 {
+		return LTBResistance{}, errors.New("c2 is required when the load is not applied at the shear centre")
+	}
+	if err := requireBeamProperties(beam, "second_moment_of_area_axis_z", "warping_constant", "torsional_constant"); err != nil {
+		return LTBResistance{}, err
+	}
+
+	section, err := CalculateBeamResistance(beam, in.SteelGrade)
+	if err != nil {
+		return LTBResistance{}, err
+	}
+	mcr, err := CriticalMoment(beam, in.EffectiveLength, in.C1, in.C2, in.LoadPosition)
+	if err != nil {
+		return LTBResistance{}, err
+	}
+
+	// Wy*fy is the characteristic cross-section moment resistance
+	mRk := section.MomentResistance * gammaM0
+	slenderness := math.Sqrt(mRk / mcr)
+	curve, alpha := ltbBucklingCurve(beam)
+
+	chi := 1.0
+	if slenderness > lambdaLT0 {
+		phi := 0.5 * (1 + alpha*(slenderness-lambdaLT0) + betaLT*slenderness*slenderness)
+		chi = 1 / (phi + math.Sqrt(phi*phi-betaLT*slenderness*slenderness))
+		chi = math.Min(chi, math.Min(1, 1/(slenderness*slenderness)))
+	}
+
+	kc := 1 / math.Sqrt(in.C1)
+	f := 1 - 0.5*(1-kc)*(1-2*(slenderness-0.8)*(slenderness-0.8))
+	f = math.Min(f, 1)
+	chiMod := math.Min(chi/f, math.Min(1, 1/(slenderness*slenderness)))
+
+	return LTBResistance{
+		SectionDesignation:   beam.SectionDesignation,
+		SteelGrade:           section.SteelGrade,
+		YieldStrength:        section.YieldStrength,
+		SectionClass:         section.SectionClass,
+		EffectiveLength:      in.EffectiveLength,
+		LoadPosition:         string(in.LoadPosition),
+		C1:                   in.C1,
+		C2:                   in.C2,
+		CriticalMoment:       mcr,
+		Slenderness:          slenderness,
+		BucklingCurve:        curve,
+		ImperfectionFactor:   alpha,
+		ReductionFactor:      chi,
+		ModificationFactor:   f,
+		ModifiedReduction:    chiMod,
+		BucklingResistance:   chiMod * mRk / gammaM1,
+		CrossSectionMomentRd: section.MomentResistance,
+		GammaM1:              gammaM1,
+	}, nil
+}
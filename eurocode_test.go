@@ -0,0 +1,152 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// catalogueBeam returns a section from the built-in tables
+func catalogueBeam(t *testing.T, designation string) SteelBeam {
+	t.Helper()
+	for _, beam := range testSeedBeams(t) {
+		if beam.SectionDesignation == designation {
+			return beam
+		}
+	}
+	t.Fatalf("%s is not in the built-in tables", designation)
+	return SteelBeam{}
+}
+
+func assertWithin(t *testing.T, name string, got, want, tolerance float64) {
+	t.Helper()
+	if math.Abs(got-want) > tolerance {
+		t.Errorf("%s = %.4g, want %.4g ± %g", name, got, want, tolerance)
+	}
+}
+
+// Mc,y,Rd = Wpl,y·fy and Vc,Rd = Av·fy/√3 worked by hand from the Blue Book
+// (SCI P363) section properties, rounded to the nearest kNm and kN as the
+// Blue Book tabulates them
+func TestCalculateBeamResistanceMatchesHandCalculation(t *testing.T) {
+	tests := []struct {
+		designation string
+		grade       string
+		fy          float64
+		class       int
+		moment      float64
+		shear       float64
+	}{
+		{"UB406x178x74", "S355", 355, 1, 533, 858},
+		{"UB406x178x74", "S275", 275, 1, 413, 664},
+		{"UB533x210x92", "S355", 355, 1, 838, 1173},
+		{"UB254x146x31", "S275", 275, 1, 108, 260},
+	}
+	for _, tt := range tests {
+		t.Run(tt.designation+"/"+tt.grade, func(t *testing.T) {
+			r, err := CalculateBeamResistance(catalogueBeam(t, tt.designation), tt.grade)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.YieldStrength != tt.fy {
+				t.Errorf("fy = %g, want %g", r.YieldStrength, tt.fy)
+			}
+			if r.SectionClass != tt.class {
+				t.Errorf("class = %d, want %d", r.SectionClass, tt.class)
+			}
+			assertWithin(t, "Mc,Rd", r.MomentResistance, tt.moment, 0.5)
+			assertWithin(t, "Vc,Rd", r.ShearResistance, tt.shear, 0.5)
+			if r.ShearBucklingCheckRequired {
+				t.Error("rolled UKB web should not need a shear buckling check")
+			}
+		})
+	}
+}
+
+func TestClassifyElementLimits(t *testing.T) {
+	limits := [3]float64{9, 10, 14}
+	tests := []struct {
+		ratio float64
+		class int
+	}{
+		{8.9, 1}, {9, 1}, {9.1, 2}, {10, 2}, {13.9, 3}, {14, 3}, {14.1, 4},
+	}
+	for _, tt := range tests {
+		if got := classifyElement(tt.ratio, limits); got != tt.class {
+			t.Errorf("classifyElement(%g) = %d, want %d", tt.ratio, got, tt.class)
+		}
+	}
+}
+
+func TestCalculateBeamResistanceRejectsClass4(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	beam.RatiosForLocalBucklingFlange = 15
+	if _, err := CalculateBeamResistance(beam, "S355"); err == nil {
+		t.Fatal("expected an error for a class 4 flange")
+	}
+}
+
+// Mcr from the SN003 formula and Mb,Rd from 6.3.2.3 for UB 406x178x74 in
+// S355, worked by hand (G = 81000 N/mm², curve c since h/b > 2). With C1 =
+// 1.0 the f factor is 1, which is the case the Blue Book tabulates.
+func TestCalculateLTBResistanceMatchesHandCalculation(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	tests := []struct {
+		name         string
+		in           LTBInput
+		mcr          float64
+		slenderness  float64
+		chi          float64
+		modification float64
+		mbRd         float64
+	}{
+		{"2m C1=1.0", LTBInput{EffectiveLength: 2}, 1714.3, 0.5573, 0.9108, 1, 485.0},
+		{"4m C1=1.0", LTBInput{EffectiveLength: 4}, 510.2, 1.0217, 0.6260, 1, 333.4},
+		{"10m C1=1.0", LTBInput{EffectiveLength: 10}, 142.8, 1.9312, 0.2622, 1, 139.6},
+		{"4m C1=1.132", LTBInput{EffectiveLength: 4, C1: 1.132}, 577.5, 0.9602, 0.6635, 0.9715, 363.7},
+		{"4m top flange", LTBInput{EffectiveLength: 4, C1: 1.132, C2: 0.459, LoadPosition: LoadAtTopFlange}, 401.0, 1.1523, 0.5506, 0.9774, 300.0},
+		{"4m bottom flange", LTBInput{EffectiveLength: 4, C1: 1.132, C2: 0.459, LoadPosition: LoadAtBottomFlange}, 831.7, 0.8002, 0.7636, 0.9699, 419.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.in.SteelGrade = "S355"
+			r, err := CalculateLTBResistance(beam, tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.BucklingCurve != "c" || r.ImperfectionFactor != 0.49 {
+				t.Errorf("curve %s (αLT %g), want c (0.49)", r.BucklingCurve, r.ImperfectionFactor)
+			}
+			assertWithin(t, "Mcr", r.CriticalMoment, tt.mcr, 0.1)
+			assertWithin(t, "λLT", r.Slenderness, tt.slenderness, 1e-4)
+			assertWithin(t, "χLT", r.ReductionFactor, tt.chi, 1e-4)
+			assertWithin(t, "f", r.ModificationFactor, tt.modification, 1e-4)
+			assertWithin(t, "Mb,Rd", r.BucklingResistance, tt.mbRd, 0.1)
+			assertWithin(t, "Mc,Rd", r.CrossSectionMomentRd, 532.5, 1e-9)
+		})
+	}
+}
+
+func TestCalculateLTBResistanceShortSegmentIsNotReduced(t *testing.T) {
+	r, err := CalculateLTBResistance(catalogueBeam(t, "UB406x178x74"), LTBInput{SteelGrade: "S355", EffectiveLength: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Slenderness > lambdaLT0 || r.ModifiedReduction != 1 {
+		t.Fatalf("λLT %.3f, χLT,mod %g; want no reduction below λLT,0", r.Slenderness, r.ModifiedReduction)
+	}
+	assertWithin(t, "Mb,Rd", r.BucklingResistance, 532.5, 1e-9)
+}
+
+func TestCalculateLTBResistanceRejectsBadInput(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	for name, in := range map[string]LTBInput{
+		"no length":        {SteelGrade: "S355"},
+		"C1 below 1":       {SteelGrade: "S355", EffectiveLength: 4, C1: 0.9},
+		"top flange no C2": {SteelGrade: "S355", EffectiveLength: 4, LoadPosition: LoadAtTopFlange},
+		"unknown position": {SteelGrade: "S355", EffectiveLength: 4, C2: 0.5, LoadPosition: "web"},
+	} {
+		if _, err := CalculateLTBResistance(beam, in); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
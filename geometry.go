@@ -0,0 +1,156 @@
+package main
+
+import (
+	"math"
+	"reflect"
+)
+
+// SectionGeometry is the nominal shape of a rolled I-section in mm. Field
+// names match the SteelBeam JSON tags they are taken from.
+type SectionGeometry struct {
+	SectionDesignation string  `json:"section_designation,omitempty"`
+	Depth              float64 `json:"depth_of_section"`
+	Width              float64 `json:"width_of_section"`
+	ThicknessWeb       float64 `json:"thickness_web"`
+	ThicknessFlange    float64 `json:"thickness_flange"`
+	RootRadius         float64 `json:"root_radius"`
+}
+
+// beamGeometry returns the dimensions of beam that its other properties are derived from
+func beamGeometry(beam SteelBeam) SectionGeometry {
+	return SectionGeometry{
+		SectionDesignation: beam.SectionDesignation,
+		Depth:              beam.DepthOfSection,
+		Width:              beam.WidthOfSection,
+		ThicknessWeb:       beam.ThicknessWeb,
+		ThicknessFlange:    beam.ThicknessFlange,
+		RootRadius:         beam.RootRadius,
+	}
+}
+
+// Validate checks the geometry describes a doubly-symmetric I-section with
+// room for the web and root radii. It returns a *ValidationError or nil.
+func (g SectionGeometry) Validate() error {
+	v := &ValidationError{}
+	for _, field := range []struct {
+		name  string
+		value float64
+	}{
+		{"depth_of_section", g.Depth},
+		{"width_of_section", g.Width},
+		{"thickness_web", g.ThicknessWeb},
+		{"thickness_flange", g.ThicknessFlange},
+	} {
+		if math.IsNaN(field.value) || math.IsInf(field.value, 0) || field.value <= 0 {
+			v.add(field.name, "is required and must be positive")
+		}
+	}
+	if math.IsNaN(g.RootRadius) || math.IsInf(g.RootRadius, 0) || g.RootRadius < 0 {
+		v.add("root_radius", "must not be negative")
+	}
+	if len(v.Errors) > 0 {
+		return v
+	}
+
+	if g.Depth-2*g.ThicknessFlange-2*g.RootRadius <= 0 {
+		v.add("depth_of_section", "must exceed both flanges and root radii")
+	}
+	if g.ThicknessWeb+2*g.RootRadius >= g.Width {
+		v.add("width_of_section", "must exceed the web thickness and both root radii")
+	}
+	if len(v.Errors) > 0 {
+		return v
+	}
+	return nil
+}
+
+// DeriveSectionProperties computes the section properties of an I-section
+// from its geometry, in the units the catalogue uses (SCI P363): mass in
+// kg/m, area in cm², second moments and It in cm⁴, radii of gyration in
+// cm, moduli in cm³ and Iw in dm⁶. Root fillets are included; the flanges
+// are taken as parallel.
+func DeriveSectionProperties(g SectionGeometry) (SteelBeam, error) {
+	if err := g.Validate(); err != nil {
+		return SteelBeam{}, err
+	}
+
+	h, b, tw, tf, r := g.Depth, g.Width, g.ThicknessWeb, g.ThicknessFlange, g.RootRadius
+	hw := h - 2*tf // clear depth between flanges
+	hs := h - tf   // distance between flange centroids
+
+	area := 2*b*tf + hw*tw + (4-math.Pi)*r*r
+	iy := (b*h*h*h-(b-tw)*hw*hw*hw)/12 + 0.03*math.Pow(r, 4) + 0.2146*r*r*math.Pow(hw-0.4468*r, 2)
+	iz := (2*tf*b*b*b+hw*tw*tw*tw)/12 + 0.03*math.Pow(r, 4) + 0.2146*r*r*math.Pow(tw+0.4468*r, 2)
+	wply := tw*h*h/4 + (b-tw)*hs*tf + (4-math.Pi)/2*r*r*hw + (3*math.Pi-10)/3*r*r*r
+	wplz := b*b*tf/2 + hw*tw*tw/4 + r*r*r*(10/3.0-math.Pi) + (2-math.Pi/2)*tw*r*r
+	iw := iz * hs * hs / 4
+
+	// St Venant torsion constant with the root fillet correction of
+	// SCI P363 Appendix A
+	alpha := -0.042 + 0.2204*tw/tf + 0.1355*r/tf - 0.0865*r*tw/(tf*tf) - 0.0725*tw*tw/(tf*tf)
+	d1 := ((tf+r)*(tf+r) + (r+0.25*tw)*tw) / (2*r + tf)
+	it := 2.0/3*b*tf*tf*tf + hw*tw*tw*tw/3 + 2*alpha*math.Pow(d1, 4) - 0.420*math.Pow(tf, 4)
+
+	mass := area * 1e-6 * steelDensity
+	d := hw - 2*r
+	perimeter := (2*h + 4*b - 2*tw - 8*r + 2*math.Pi*r) / 1000
+
+	return SteelBeam{
+		SectionDesignation:           g.SectionDesignation,
+		MassPerMetre:                 mass,
+		DepthOfSection:               h,
+		WidthOfSection:               b,
+		ThicknessWeb:                 tw,
+		ThicknessFlange:              tf,
+		RootRadius:                   r,
+		DepthBetweenFillets:          d,
+		RatiosForLocalBucklingWeb:    d / tw,
+		RatiosForLocalBucklingFlange: (b - tw - 2*r) / 2 / tf,
+		EndClearance:                 math.Ceil(tw/2 + 2),
+		Notch:                        roundUpToEven((b-tw)/2 + 10),
+		DimensionsForDetailingN:      roundUpToEven(tf + r),
+		SurfaceAreaPerMetre:          perimeter,
+		SurfaceAreaPerTonne:          perimeter / (mass / 1000),
+		SecondMomentOfAreaAxisY:      iy / 1e4,
+		SecondMomentOfAreaAxisZ:      iz / 1e4,
+		RadiusOfGyrationAxisY:        math.Sqrt(iy/area) / 10,
+		RadiusOfGyrationAxisZ:        math.Sqrt(iz/area) / 10,
+		ElasticModulusAxisY:          2 * iy / h / 1e3,
+		ElasticModulusAxisZ:          2 * iz / b / 1e3,
+		PlasticModulusAxisY:          wply / 1e3,
+		PlasticModulusAxisZ:          wplz / 1e3,
+		BucklingParameter:            math.Pow(4*wply*wply*(1-iz/iy)/(area*area*hs*hs), 0.25),
+		TorsionalIndex:               0.566 * hs * math.Sqrt(area/it),
+		WarpingConstant:              iw / 1e12,
+		TorsionalConstant:            it / 1e4,
+		AreaOfSection:                area / 100,
+	}, nil
+}
+
+// FillDerivedProperties returns beam with every property left at zero
+// replaced by the value derived from its geometry. Properties already given
+// are kept as they are.
+func FillDerivedProperties(beam SteelBeam) (SteelBeam, error) {
+	derived, err := DeriveSectionProperties(beamGeometry(beam))
+	if err != nil {
+		return SteelBeam{}, err
+	}
+
+	filled := reflect.ValueOf(&beam).Elem()
+	source := reflect.ValueOf(derived)
+	for _, column := range beamColumns {
+		if column.Name == "section_designation" {
+			continue
+		}
+		if field := filled.Field(column.Index); field.Float() == 0 {
+			field.SetFloat(source.Field(column.Index).Float())
+		}
+	}
+	return beam, nil
+}
+
+// roundUpToEven rounds up to the next even millimetre, as detailing
+// dimensions are tabulated
+func roundUpToEven(v float64) float64 {
+	return math.Ceil(v/2) * 2
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Derived properties must reproduce the Blue Book values within the default
+// audit tolerance; detailing dimensions are rounded and must match exactly
+func TestDeriveSectionPropertiesMatchesCatalogue(t *testing.T) {
+	exact := map[string]bool{"end_clearance": true, "notch": true, "dimensions_for_detailing_n": true}
+	for _, designation := range []string{"UB203x133x25", "UB254x146x31", "UB406x178x74", "UB533x210x92"} {
+		t.Run(designation, func(t *testing.T) {
+			beam := catalogueBeam(t, designation)
+			derived, err := DeriveSectionProperties(beamGeometry(beam))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, got := reflect.ValueOf(beam), reflect.ValueOf(derived)
+			for _, column := range beamColumns {
+				if column.Name == "section_designation" {
+					continue
+				}
+				w, g := want.Field(column.Index).Float(), got.Field(column.Index).Float()
+				if exact[column.Name] {
+					if g != w {
+						t.Errorf("%s = %g, want %g", column.Name, g, w)
+					}
+					continue
+				}
+				if deviation := 100 * math.Abs(g-w) / w; deviation > defaultAuditTolerance {
+					t.Errorf("%s = %.4g, catalogue %.4g (%.2f%% off)", column.Name, g, w, deviation)
+				}
+			}
+		})
+	}
+}
+
+func TestSectionGeometryValidate(t *testing.T) {
+	valid := beamGeometry(catalogueBeam(t, "UB406x178x74"))
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("catalogue geometry rejected: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		edit  func(*SectionGeometry)
+		field string
+	}{
+		{"missing depth", func(g *SectionGeometry) { g.Depth = 0 }, "depth_of_section"},
+		{"NaN web", func(g *SectionGeometry) { g.ThicknessWeb = math.NaN() }, "thickness_web"},
+		{"negative radius", func(g *SectionGeometry) { g.RootRadius = -1 }, "root_radius"},
+		{"flanges fill depth", func(g *SectionGeometry) { g.ThicknessFlange = g.Depth / 2 }, "depth_of_section"},
+		{"web wider than flange", func(g *SectionGeometry) { g.ThicknessWeb = g.Width }, "width_of_section"},
+	}
+	for _, tt := range tests {
+		g := valid
+		tt.edit(&g)
+		err := g.Validate()
+		var v *ValidationError
+		if !errors.As(err, &v) || len(v.Errors) == 0 || v.Errors[0].Field != tt.field {
+			t.Errorf("%s: got %v, want an error on %s", tt.name, err, tt.field)
+		}
+	}
+}
+
+func TestFillDerivedPropertiesKeepsGivenValues(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	partial := SteelBeam{SectionDesignation: beam.SectionDesignation, MassPerMetre: 99}
+	partial.DepthOfSection, partial.WidthOfSection = beam.DepthOfSection, beam.WidthOfSection
+	partial.ThicknessWeb, partial.ThicknessFlange, partial.RootRadius = beam.ThicknessWeb, beam.ThicknessFlange, beam.RootRadius
+
+	filled, err := FillDerivedProperties(partial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filled.MassPerMetre != 99 {
+		t.Errorf("mass_per_metre = %g, want the given 99", filled.MassPerMetre)
+	}
+	assertWithin(t, "plastic_modulus_axis_y", filled.PlasticModulusAxisY, beam.PlasticModulusAxisY, beam.PlasticModulusAxisY/100)
+}
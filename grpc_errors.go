@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the google.rpc.ErrorInfo domain of every error this service returns
+const errorDomain = "formandfunction-api"
+
+// ErrorInfo reasons. Clients should branch on these rather than on messages.
+const (
+	reasonInvalidArgument  = "INVALID_ARGUMENT"
+	reasonBeamNotFound     = "BEAM_NOT_FOUND"
+	reasonBeamExists       = "BEAM_ALREADY_EXISTS"
+	reasonInvalidBeam      = "INVALID_BEAM"
+	reasonSectionNotFound  = "SECTION_NOT_FOUND"
+	reasonMaterialNotFound = "MATERIAL_NOT_FOUND"
+	reasonStorageFailure   = "STORAGE_FAILURE"
+	reasonStockUnavailable = "STOCK_PROVIDER_UNAVAILABLE"
+	reasonResumeExpired    = "RESUME_TOKEN_EXPIRED"
+	reasonWatcherTooSlow   = "WATCHER_TOO_SLOW"
+)
+
+// statusError builds a gRPC status error carrying a google.rpc.ErrorInfo detail
+func statusError(code codes.Code, reason, message string, metadata map[string]string) error {
+	st := status.New(code, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// invalidArgumentError reports a request the service rejected
+func invalidArgumentError(err error) error {
+	return statusError(codes.InvalidArgument, reasonInvalidArgument, err.Error(), nil)
+}
+
+// beamError maps a repository or BeamService error for the named beam to a
+// status error. Validation failures carry a google.rpc.BadRequest detail
+// listing each field.
+func beamError(sectionDesignation string, err error) error {
+	var invalid *ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		st := status.New(codes.InvalidArgument, err.Error())
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(invalid.Errors))
+		for i, fe := range invalid.Errors {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: fe.Field, Description: fe.Message}
+		}
+		detailed, detailErr := st.WithDetails(
+			&errdetails.ErrorInfo{Reason: reasonInvalidBeam, Domain: errorDomain,
+				Metadata: map[string]string{"section_designation": sectionDesignation}},
+			&errdetails.BadRequest{FieldViolations: violations},
+		)
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	case errors.Is(err, ErrBeamExists):
+		return statusError(codes.AlreadyExists, reasonBeamExists,
+			fmt.Sprintf("beam %s already exists", sectionDesignation),
+			map[string]string{"section_designation": sectionDesignation})
+	case errors.Is(err, ErrBeamNotFound):
+		return statusError(codes.NotFound, reasonBeamNotFound,
+			fmt.Sprintf("beam %s not found", sectionDesignation),
+			map[string]string{"section_designation": sectionDesignation})
+	}
+	return storageError(err)
+}
+
+// storageError reports a failure reading or writing the beam catalogue
+func storageError(err error) error {
+	return statusError(codes.Internal, reasonStorageFailure, err.Error(), nil)
+}
+
+// sectionError maps a section catalogue error to a status error
+func sectionError(sectionDesignation string, err error) error {
+	if errors.Is(err, ErrSectionNotFound) {
+		return statusError(codes.NotFound, reasonSectionNotFound,
+			fmt.Sprintf("section %s not found", sectionDesignation),
+			map[string]string{"section_designation": sectionDesignation})
+	}
+	return storageError(err)
+}
+
+// materialError maps a material lookup error to a status error
+func materialError(grade string, err error) error {
+	if errors.Is(err, ErrUnknownSteelGrade) {
+		return statusError(codes.NotFound, reasonMaterialNotFound, err.Error(),
+			map[string]string{"steel_grade": grade})
+	}
+	return invalidArgumentError(err)
+}
+
+// eventLogError maps a resume token error to a status error. An expired
+// token is OUT_OF_RANGE so clients know to reload rather than retry.
+func eventLogError(err error) error {
+	if errors.Is(err, ErrResumeTokenExpired) {
+		return statusError(codes.OutOfRange, reasonResumeExpired, err.Error(), nil)
+	}
+	return invalidArgumentError(err)
+}
@@ -2,17 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log"
 	"net"
 
 	pb "formandfunction-api/proto"
+	steelbeamv1 "formandfunction-api/proto/v1"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// server is used to implement steelbeam.SteelBeamServiceServer
+// server is used to implement steelbeam.SteelBeamServiceServer, the original
+// unversioned service; new clients should use steelbeam.v1 (see serverV1)
 type server struct {
 	pb.UnimplementedSteelBeamServiceServer
+	repo     BeamRepository
+	beams    *BeamService
+	events   *BeamEventLog
+	sections *SectionCatalogue
+	stock    *StockProviders
 }
 
 // Helper function to convert Go SteelBeam to protobuf SteelBeam
@@ -49,6 +60,45 @@ func steelBeamToProto(beam SteelBeam) *pb.SteelBeam {
 	}
 }
 
+// importReportToProto converts an ImportReport to its protobuf message
+func importReportToProto(report ImportReport) *pb.ImportBeamsResponse {
+	rows := make([]*pb.ImportRowResult, len(report.Rows))
+	for i, row := range report.Rows {
+		errs := make([]*pb.FieldError, len(row.Errors))
+		for j, fe := range row.Errors {
+			errs[j] = &pb.FieldError{Field: fe.Field, Message: fe.Message}
+		}
+		rows[i] = &pb.ImportRowResult{
+			Row:                int32(row.Row),
+			SectionDesignation: row.SectionDesignation,
+			Action:             string(row.Action),
+			Error:              row.Error,
+			Errors:             errs,
+		}
+	}
+	return &pb.ImportBeamsResponse{
+		Mode:    string(report.Mode),
+		DryRun:  report.DryRun,
+		Total:   int32(report.Total),
+		Created: int32(report.Created),
+		Updated: int32(report.Updated),
+		Failed:  int32(report.Failed),
+		Rows:    rows,
+	}
+}
+
+// protoToSectionGeometry converts a derive request to SectionGeometry
+func protoToSectionGeometry(req *pb.DeriveSectionPropertiesRequest) SectionGeometry {
+	return SectionGeometry{
+		SectionDesignation: req.SectionDesignation,
+		Depth:              req.DepthOfSection,
+		Width:              req.WidthOfSection,
+		ThicknessWeb:       req.ThicknessWeb,
+		ThicknessFlange:    req.ThicknessFlange,
+		RootRadius:         req.RootRadius,
+	}
+}
+
 // Helper function to convert protobuf SteelBeam to Go SteelBeam
 func protoToSteelBeam(pbBeam *pb.SteelBeam) SteelBeam {
 	return SteelBeam{
@@ -83,17 +133,47 @@ func protoToSteelBeam(pbBeam *pb.SteelBeam) SteelBeam {
 	}
 }
 
-// GetBeams returns all steel beams
+// GetBeams returns steel beams, optionally filtered, sorted and paginated
 func (s *server) GetBeams(ctx context.Context, req *pb.GetBeamsRequest) (*pb.GetBeamsResponse, error) {
 	log.Printf("gRPC GetBeams called")
 
+	query := BeamQuery{
+		SortBy:     req.SortBy,
+		Descending: req.Descending,
+		Offset:     int(req.Offset),
+		Limit:      int(req.Limit),
+	}
+	for _, filter := range req.Filters {
+		query.Filters = append(query.Filters, BeamRangeFilter{
+			Field: filter.Field,
+			Min:   filter.Min,
+			Max:   filter.Max,
+		})
+	}
+	if err := query.Validate(); err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	units, err := ParseUnitSystem(req.Units)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	beams, err := s.repo.List()
+	if err != nil {
+		return nil, storageError(err)
+	}
+	page := query.InUnits(units).Apply(beams)
+	page.Beams = ConvertBeams(page.Beams, units)
+
 	var protoBeams []*pb.SteelBeam
-	for _, beam := range beams {
+	for _, beam := range page.Beams {
 		protoBeams = append(protoBeams, steelBeamToProto(beam))
 	}
 
 	return &pb.GetBeamsResponse{
-		Beams: protoBeams,
+		Beams:      protoBeams,
+		TotalCount: int32(page.Total),
+		NextOffset: int32(page.NextOffset),
 	}, nil
 }
 
@@ -101,26 +181,55 @@ func (s *server) GetBeams(ctx context.Context, req *pb.GetBeamsRequest) (*pb.Get
 func (s *server) GetBeam(ctx context.Context, req *pb.GetBeamRequest) (*pb.GetBeamResponse, error) {
 	log.Printf("gRPC GetBeam called with section: %s", req.SectionDesignation)
 
-	for _, beam := range beams {
-		if beam.SectionDesignation == req.SectionDesignation {
-			return &pb.GetBeamResponse{
-				Beam:  steelBeamToProto(beam),
-				Found: true,
-			}, nil
-		}
+	units, err := ParseUnitSystem(req.Units)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	beam, err := s.repo.Get(req.SectionDesignation)
+	if errors.Is(err, ErrBeamNotFound) {
+		return &pb.GetBeamResponse{
+			Found: false,
+		}, nil
+	}
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
 	}
 
 	return &pb.GetBeamResponse{
-		Found: false,
+		Beam:  steelBeamToProto(ConvertBeam(beam, units)),
+		Found: true,
 	}, nil
 }
 
 // CreateBeam creates a new steel beam
 func (s *server) CreateBeam(ctx context.Context, req *pb.CreateBeamRequest) (*pb.CreateBeamResponse, error) {
+	if req.Beam == nil {
+		return &pb.CreateBeamResponse{
+			Success: false,
+			Message: "beam is required",
+		}, nil
+	}
 	log.Printf("gRPC CreateBeam called for section: %s", req.Beam.SectionDesignation)
 
-	newBeam := protoToSteelBeam(req.Beam)
-	beams = append(beams, newBeam)
+	beam := protoToSteelBeam(req.Beam)
+	if req.DeriveMissing {
+		derived, err := FillDerivedProperties(beam)
+		if err != nil {
+			return &pb.CreateBeamResponse{
+				Success: false,
+				Message: beamServiceMessage(err),
+			}, nil
+		}
+		beam = derived
+	}
+
+	newBeam, err := s.beams.CreateBeam(beam)
+	if err != nil {
+		return &pb.CreateBeamResponse{
+			Success: false,
+			Message: beamServiceMessage(err),
+		}, nil
+	}
 
 	return &pb.CreateBeamResponse{
 		Beam:    steelBeamToProto(newBeam),
@@ -129,15 +238,593 @@ func (s *server) CreateBeam(ctx context.Context, req *pb.CreateBeamRequest) (*pb
 	}, nil
 }
 
-// GetStockStatus returns stock status for a product
+// UpdateBeam replaces an existing beam
+func (s *server) UpdateBeam(ctx context.Context, req *pb.UpdateBeamRequest) (*pb.UpdateBeamResponse, error) {
+	log.Printf("gRPC UpdateBeam called for section: %s", req.SectionDesignation)
+
+	if req.Beam == nil {
+		return &pb.UpdateBeamResponse{
+			Success: false,
+			Message: "beam is required",
+		}, nil
+	}
+	updated, err := s.beams.UpdateBeam(req.SectionDesignation, protoToSteelBeam(req.Beam))
+	if err != nil {
+		return &pb.UpdateBeamResponse{
+			Success: false,
+			Message: beamServiceMessage(err),
+		}, nil
+	}
+
+	return &pb.UpdateBeamResponse{
+		Beam:    steelBeamToProto(updated),
+		Success: true,
+		Message: "Beam updated successfully",
+	}, nil
+}
+
+// DeleteBeam removes a beam
+func (s *server) DeleteBeam(ctx context.Context, req *pb.DeleteBeamRequest) (*pb.DeleteBeamResponse, error) {
+	log.Printf("gRPC DeleteBeam called for section: %s", req.SectionDesignation)
+
+	if err := s.beams.DeleteBeam(req.SectionDesignation); err != nil {
+		return &pb.DeleteBeamResponse{
+			Success: false,
+			Message: beamServiceMessage(err),
+		}, nil
+	}
+
+	return &pb.DeleteBeamResponse{
+		Success: true,
+		Message: "Beam deleted successfully",
+	}, nil
+}
+
+// beamServiceMessage reports a failed write with the same wording as the REST API
+func beamServiceMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrBeamNotFound):
+		return "Beam not found"
+	case errors.Is(err, ErrBeamExists):
+		return "Beam already exists"
+	}
+	return err.Error()
+}
+
+// ImportBeams creates or replaces the streamed beams one at a time. An
+// options message may come first; every beam's result is reported in the
+// response, so only malformed streams return an error.
+func (s *server) ImportBeams(stream pb.SteelBeamService_ImportBeamsServer) error {
+	log.Printf("gRPC ImportBeams called")
+
+	var importer *BeamImport
+	row := 0
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch item := req.Item.(type) {
+		case *pb.ImportBeamsRequest_Options:
+			if importer != nil {
+				return invalidArgumentError(errors.New("options must be the first message of the stream"))
+			}
+			mode, err := ParseImportMode(item.Options.Mode)
+			if err != nil {
+				return invalidArgumentError(err)
+			}
+			importer = s.beams.NewImport(ImportOptions{
+				Mode:   mode,
+				DryRun: item.Options.DryRun,
+				Derive: item.Options.DeriveMissing,
+			})
+		case *pb.ImportBeamsRequest_Beam:
+			if importer == nil {
+				importer = s.beams.NewImport(ImportOptions{})
+			}
+			row++
+			importer.Add(row, protoToSteelBeam(item.Beam))
+		default:
+			return invalidArgumentError(errors.New("each message must set options or beam"))
+		}
+	}
+	if importer == nil {
+		importer = s.beams.NewImport(ImportOptions{})
+	}
+
+	report := importer.Report()
+	log.Printf("gRPC ImportBeams imported %d beams (dry run: %t): %d created, %d updated, %d failed",
+		report.Total, report.DryRun, report.Created, report.Updated, report.Failed)
+	return stream.SendAndClose(importReportToProto(report))
+}
+
+// WatchBeams streams catalogue changes made through either API. Events
+// after req.ResumeToken are replayed first when it is set.
+func (s *server) WatchBeams(req *pb.WatchBeamsRequest, stream pb.SteelBeamService_WatchBeamsServer) error {
+	log.Printf("gRPC WatchBeams called with resume token: %q", req.ResumeToken)
+
+	after, err := s.events.ParseResumeToken(req.ResumeToken)
+	if err != nil {
+		return eventLogError(err)
+	}
+	backlog, events, cancel, err := s.events.Subscribe(after)
+	if err != nil {
+		return eventLogError(err)
+	}
+	defer cancel()
+
+	for _, event := range backlog {
+		if err := stream.Send(s.beamEventToProto(event)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return statusError(codes.ResourceExhausted, reasonWatcherTooSlow,
+					"watcher fell behind; resume from the last token received", nil)
+			}
+			if err := stream.Send(s.beamEventToProto(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Helper function to convert a Go BeamEvent to protobuf
+func (s *server) beamEventToProto(event BeamEvent) *pb.BeamEvent {
+	out := &pb.BeamEvent{
+		Type:               string(event.Type),
+		SectionDesignation: event.SectionDesignation,
+		ResumeToken:        s.events.ResumeToken(event),
+		OccurredAt:         timestamppb.New(event.OccurredAt),
+	}
+	if event.Beam != nil {
+		out.Beam = steelBeamToProto(*event.Beam)
+	}
+	return out
+}
+
+// SelectBeam returns the lightest beams meeting the requested bounds
+func (s *server) SelectBeam(ctx context.Context, req *pb.SelectBeamRequest) (*pb.SelectBeamResponse, error) {
+	log.Printf("gRPC SelectBeam called")
+
+	beams, err := s.repo.List()
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	candidates, err := SelectBeams(beams, BeamSelection{
+		Minimums: req.Minimums,
+		Maximums: req.Maximums,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	var protoCandidates []*pb.BeamCandidate
+	for _, candidate := range candidates {
+		protoCandidates = append(protoCandidates, &pb.BeamCandidate{
+			Beam: steelBeamToProto(candidate.Beam),
+			Governing: &pb.GoverningConstraint{
+				Field:       candidate.Governing.Field,
+				Bound:       candidate.Governing.Bound,
+				Limit:       candidate.Governing.Limit,
+				Value:       candidate.Governing.Value,
+				Utilisation: candidate.Governing.Utilisation,
+			},
+		})
+	}
+
+	return &pb.SelectBeamResponse{
+		Candidates: protoCandidates,
+	}, nil
+}
+
+// DeriveSectionProperties computes the section properties of an I-section from its geometry
+func (s *server) DeriveSectionProperties(ctx context.Context, req *pb.DeriveSectionPropertiesRequest) (*pb.DeriveSectionPropertiesResponse, error) {
+	log.Printf("gRPC DeriveSectionProperties called for section: %s", req.SectionDesignation)
+
+	beam, err := DeriveSectionProperties(protoToSectionGeometry(req))
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+	return &pb.DeriveSectionPropertiesResponse{Beam: steelBeamToProto(beam)}, nil
+}
+
+// CalculateBeamResistance classifies a beam and returns its cross-section resistances
+func (s *server) CalculateBeamResistance(ctx context.Context, req *pb.BeamResistanceRequest) (*pb.BeamResistanceResponse, error) {
+	log.Printf("gRPC CalculateBeamResistance called for section: %s, grade: %s", req.SectionDesignation, req.SteelGrade)
+
+	beam, err := s.repo.Get(req.SectionDesignation)
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+
+	r, err := CalculateBeamResistance(beam, req.SteelGrade)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	return &pb.BeamResistanceResponse{
+		SectionDesignation:         r.SectionDesignation,
+		SteelGrade:                 r.SteelGrade,
+		YieldStrength:              r.YieldStrength,
+		Epsilon:                    r.Epsilon,
+		WebClass:                   int32(r.WebClass),
+		FlangeClass:                int32(r.FlangeClass),
+		SectionClass:               int32(r.SectionClass),
+		MomentResistance:           r.MomentResistance,
+		ShearArea:                  r.ShearArea,
+		ShearResistance:            r.ShearResistance,
+		ShearBucklingCheckRequired: r.ShearBucklingCheckRequired,
+		GammaM0:                    r.GammaM0,
+	}, nil
+}
+
+// CalculateLTBResistance returns the lateral-torsional buckling resistance of a beam segment
+func (s *server) CalculateLTBResistance(ctx context.Context, req *pb.LTBRequest) (*pb.LTBResponse, error) {
+	log.Printf("gRPC CalculateLTBResistance called for section: %s, grade: %s", req.SectionDesignation, req.SteelGrade)
+
+	beam, err := s.repo.Get(req.SectionDesignation)
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+
+	r, err := CalculateLTBResistance(beam, LTBInput{
+		SteelGrade:      req.SteelGrade,
+		EffectiveLength: req.EffectiveLength,
+		LoadPosition:    LoadPosition(req.LoadPosition),
+		C1:              req.C1,
+		C2:              req.C2,
+	})
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	return &pb.LTBResponse{
+		SectionDesignation:           r.SectionDesignation,
+		SteelGrade:                   r.SteelGrade,
+		YieldStrength:                r.YieldStrength,
+		SectionClass:                 int32(r.SectionClass),
+		EffectiveLength:              r.EffectiveLength,
+		LoadPosition:                 r.LoadPosition,
+		C1:                           r.C1,
+		C2:                           r.C2,
+		CriticalMoment:               r.CriticalMoment,
+		Slenderness:                  r.Slenderness,
+		BucklingCurve:                r.BucklingCurve,
+		ImperfectionFactor:           r.ImperfectionFactor,
+		ReductionFactor:              r.ReductionFactor,
+		ModificationFactor:           r.ModificationFactor,
+		ModifiedReductionFactor:      r.ModifiedReduction,
+		BucklingResistance:           r.BucklingResistance,
+		CrossSectionMomentResistance: r.CrossSectionMomentRd,
+		GammaM1:                      r.GammaM1,
+	}, nil
+}
+
+// DesignSimplySupported checks candidate beams for a simply-supported span,
+// streaming results lightest first as each section is checked
+func (s *server) DesignSimplySupported(req *pb.SimplySupportedDesignRequest, stream pb.SteelBeamService_DesignSimplySupportedServer) error {
+	log.Printf("gRPC DesignSimplySupported called for span: %.2f m, grade: %s", req.Span, req.SteelGrade)
+
+	beams, err := s.repo.List()
+	if err != nil {
+		return storageError(err)
+	}
+
+	design := SimplySupportedDesign{
+		Span:       req.Span,
+		SteelGrade: req.SteelGrade,
+		LoadFactors: LoadFactors{
+			Permanent: req.GammaG,
+			Variable:  req.GammaQ,
+		},
+		LateralRestraint: LateralRestraint{
+			Continuous: req.ContinuousRestraint,
+			Positions:  req.RestraintPositions,
+		},
+		LoadPosition:     LoadPosition(req.LoadPosition),
+		DeflectionLimit:  req.DeflectionLimit,
+		IgnoreSelfWeight: req.IgnoreSelfWeight,
+		Sections:         req.Sections,
+		OnlyPassing:      req.OnlyPassing,
+		Limit:            int(req.Limit),
+	}
+	if req.Udl != nil {
+		design.UDL = DesignLoad{Permanent: req.Udl.Permanent, Variable: req.Udl.Variable}
+	}
+	for _, p := range req.PointLoads {
+		design.PointLoads = append(design.PointLoads, PointLoad{Position: p.Position, Permanent: p.Permanent, Variable: p.Variable})
+	}
+
+	var sendErr error
+	err = DesignSimplySupported(beams, design, func(result SectionDesignResult) error {
+		sendErr = stream.Send(sectionDesignResultToProto(result))
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return invalidArgumentError(err)
+	}
+	return nil
+}
+
+// Helper function to convert a design result to protobuf
+func sectionDesignResultToProto(result SectionDesignResult) *pb.SectionDesignResult {
+	check := func(c *DesignCheck) *pb.DesignCheck {
+		if c == nil {
+			return nil
+		}
+		return &pb.DesignCheck{DesignValue: c.DesignValue, Resistance: c.Resistance, Utilisation: c.Utilisation}
+	}
+
+	out := &pb.SectionDesignResult{
+		SectionDesignation: result.SectionDesignation,
+		MassPerMetre:       result.MassPerMetre,
+		SectionClass:       int32(result.SectionClass),
+		Passes:             result.Passes,
+		Governing:          result.Governing,
+		MaxUtilisation:     result.MaxUtilisation,
+		Bending:            check(result.Bending),
+		Shear:              check(result.Shear),
+		ShearAtMaxMoment:   result.ShearAtMaxMoment,
+		HighShear:          result.HighShear,
+		ShearReduction:     result.ShearReduction,
+		Deflection:         check(result.Deflection),
+		Error:              result.Error,
+	}
+	if result.LTB != nil {
+		out.Ltb = &pb.LTBCheck{
+			Check:           check(&result.LTB.DesignCheck),
+			SegmentStart:    result.LTB.SegmentStart,
+			SegmentEnd:      result.LTB.SegmentEnd,
+			EffectiveLength: result.LTB.EffectiveLength,
+			C1:              result.LTB.C1,
+			C2:              result.LTB.C2,
+		}
+	}
+	return out
+}
+
+// Helper function to convert Go Section to protobuf Section
+func sectionToProto(section Section) *pb.Section {
+	out := &pb.Section{
+		SectionDesignation:      section.SectionDesignation,
+		Family:                  string(section.Family),
+		MassPerMetre:            section.MassPerMetre,
+		AreaOfSection:           section.AreaOfSection,
+		SurfaceAreaPerMetre:     section.SurfaceAreaPerMetre,
+		SecondMomentOfAreaAxisY: section.SecondMomentOfAreaAxisY,
+		SecondMomentOfAreaAxisZ: section.SecondMomentOfAreaAxisZ,
+		RadiusOfGyrationAxisY:   section.RadiusOfGyrationAxisY,
+		RadiusOfGyrationAxisZ:   section.RadiusOfGyrationAxisZ,
+		ElasticModulusAxisY:     section.ElasticModulusAxisY,
+		ElasticModulusAxisZ:     section.ElasticModulusAxisZ,
+		PlasticModulusAxisY:     section.PlasticModulusAxisY,
+		PlasticModulusAxisZ:     section.PlasticModulusAxisZ,
+		TorsionalConstant:       section.TorsionalConstant,
+	}
+
+	switch {
+	case section.ISection != nil:
+		p := section.ISection
+		out.Properties = &pb.Section_ISection{ISection: &pb.ISectionProperties{
+			DepthOfSection:      p.DepthOfSection,
+			WidthOfSection:      p.WidthOfSection,
+			ThicknessWeb:        p.ThicknessWeb,
+			ThicknessFlange:     p.ThicknessFlange,
+			RootRadius:          p.RootRadius,
+			DepthBetweenFillets: p.DepthBetweenFillets,
+			BucklingParameter:   p.BucklingParameter,
+			TorsionalIndex:      p.TorsionalIndex,
+			WarpingConstant:     p.WarpingConstant,
+		}}
+	case section.Channel != nil:
+		p := section.Channel
+		out.Properties = &pb.Section_Channel{Channel: &pb.ChannelProperties{
+			DepthOfSection:          p.DepthOfSection,
+			WidthOfSection:          p.WidthOfSection,
+			ThicknessWeb:            p.ThicknessWeb,
+			ThicknessFlange:         p.ThicknessFlange,
+			RootRadius:              p.RootRadius,
+			DepthBetweenFillets:     p.DepthBetweenFillets,
+			CentroidDistance:        p.CentroidDistance,
+			ShearCentreFromCentroid: p.ShearCentreFromCentroid,
+			WarpingConstant:         p.WarpingConstant,
+		}}
+	case section.Angle != nil:
+		p := section.Angle
+		out.Properties = &pb.Section_Angle{Angle: &pb.AngleProperties{
+			LegLengthLong:           p.LegLengthLong,
+			LegLengthShort:          p.LegLengthShort,
+			Thickness:               p.Thickness,
+			RootRadius:              p.RootRadius,
+			ToeRadius:               p.ToeRadius,
+			CentroidDistanceY:       p.CentroidDistanceY,
+			CentroidDistanceZ:       p.CentroidDistanceZ,
+			SecondMomentOfAreaAxisU: p.SecondMomentOfAreaAxisU,
+			SecondMomentOfAreaAxisV: p.SecondMomentOfAreaAxisV,
+			RadiusOfGyrationAxisU:   p.RadiusOfGyrationAxisU,
+			RadiusOfGyrationAxisV:   p.RadiusOfGyrationAxisV,
+			TanAlpha:                p.TanAlpha,
+		}}
+	case section.Hollow != nil:
+		p := section.Hollow
+		out.Properties = &pb.Section_Hollow{Hollow: &pb.HollowSectionProperties{
+			OutsideDiameter:  p.OutsideDiameter,
+			DepthOfSection:   p.DepthOfSection,
+			WidthOfSection:   p.WidthOfSection,
+			WallThickness:    p.WallThickness,
+			TorsionalModulus: p.TorsionalModulus,
+		}}
+	case section.Tee != nil:
+		p := section.Tee
+		out.Properties = &pb.Section_Tee{Tee: &pb.TeeProperties{
+			DepthOfSection:   p.DepthOfSection,
+			WidthOfSection:   p.WidthOfSection,
+			ThicknessWeb:     p.ThicknessWeb,
+			ThicknessFlange:  p.ThicknessFlange,
+			RootRadius:       p.RootRadius,
+			CentroidDistance: p.CentroidDistance,
+		}}
+	}
+	return out
+}
+
+// GetSections returns all sections, optionally filtered by family
+func (s *server) GetSections(ctx context.Context, req *pb.GetSectionsRequest) (*pb.GetSectionsResponse, error) {
+	log.Printf("gRPC GetSections called with family: %s", req.Family)
+
+	var family SectionFamily
+	if req.Family != "" {
+		var err error
+		if family, err = ParseSectionFamily(req.Family); err != nil {
+			return nil, invalidArgumentError(err)
+		}
+	}
+
+	sections, err := s.sections.List(family)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	var protoSections []*pb.Section
+	for _, section := range sections {
+		protoSections = append(protoSections, sectionToProto(section))
+	}
+
+	return &pb.GetSectionsResponse{
+		Sections: protoSections,
+	}, nil
+}
+
+// GetSection returns a specific section of any family by designation
+func (s *server) GetSection(ctx context.Context, req *pb.GetSectionRequest) (*pb.GetSectionResponse, error) {
+	log.Printf("gRPC GetSection called with section: %s", req.SectionDesignation)
+
+	section, err := s.sections.Get(req.SectionDesignation)
+	if errors.Is(err, ErrSectionNotFound) {
+		return &pb.GetSectionResponse{
+			Found: false,
+		}, nil
+	}
+	if err != nil {
+		return nil, sectionError(req.SectionDesignation, err)
+	}
+
+	return &pb.GetSectionResponse{
+		Section: sectionToProto(section),
+		Found:   true,
+	}, nil
+}
+
+// GetMaterials returns steel grades, optionally one grade by name, with
+// strengths resolved at a thickness when one is given
+func (s *server) GetMaterials(ctx context.Context, req *pb.GetMaterialsRequest) (*pb.GetMaterialsResponse, error) {
+	log.Printf("gRPC GetMaterials called with grade: %s", req.Grade)
+
+	materials := ListMaterials()
+	if req.Grade != "" {
+		material, err := LookupMaterial(req.Grade)
+		if err != nil {
+			return nil, materialError(req.Grade, err)
+		}
+		materials = []SteelMaterial{material}
+	}
+
+	response := &pb.GetMaterialsResponse{}
+	for _, material := range materials {
+		response.Materials = append(response.Materials, materialToProto(material))
+		if req.Thickness == nil {
+			continue
+		}
+		strength, err := material.Strength(*req.Thickness)
+		if err != nil {
+			return nil, invalidArgumentError(err)
+		}
+		response.Strengths = append(response.Strengths, &pb.MaterialStrength{
+			Grade:            strength.Grade,
+			Thickness:        strength.Thickness,
+			YieldStrength:    strength.YieldStrength,
+			UltimateStrength: strength.UltimateStrength,
+		})
+	}
+	return response, nil
+}
+
+// Helper function to convert a Go SteelMaterial to protobuf Material
+func materialToProto(material SteelMaterial) *pb.Material {
+	bands := func(in []StrengthBand) []*pb.StrengthBand {
+		out := make([]*pb.StrengthBand, len(in))
+		for i, band := range in {
+			out[i] = &pb.StrengthBand{MaxThickness: band.MaxThickness, Value: band.Value}
+		}
+		return out
+	}
+	return &pb.Material{
+		Grade:            material.Grade,
+		Standard:         material.Standard,
+		Description:      material.Description,
+		YieldStrength:    bands(material.YieldStrength),
+		UltimateStrength: bands(material.UltimateStrength),
+		ElasticModulus:   material.ElasticModulus,
+		ShearModulus:     material.ShearModulus,
+		PoissonsRatio:    material.PoissonsRatio,
+		Density:          material.Density,
+		ThermalExpansion: material.ThermalExpansion,
+	}
+}
+
+// GetStockStatus returns stock status for a product at the default
+// merchant, the one named by supplier, or every merchant for "all"
 func (s *server) GetStockStatus(ctx context.Context, req *pb.GetStockStatusRequest) (*pb.GetStockStatusResponse, error) {
-	log.Printf("gRPC GetStockStatus called for product: %s, postcode: %s", req.ProductId, req.Postcode)
+	log.Printf("gRPC GetStockStatus called for product: %s, postcode: %s, supplier: %q", req.ProductId, req.Postcode, req.Supplier)
 
-	status, err := GetStockStatus(req.ProductId, req.Postcode)
+	query := stockQueryFromProto(req)
+	if err := query.Validate(); err != nil {
+		return &pb.GetStockStatusResponse{
+			ProductId: req.ProductId,
+			Postcode:  req.Postcode,
+			Supplier:  req.Supplier,
+			Success:   false,
+			Message:   err.Error(),
+		}, nil
+	}
+	if req.Supplier == AllStockProviders {
+		results := s.stock.FanOut(ctx, query)
+		status := MergeStockStatus(results)
+		resp := &pb.GetStockStatusResponse{
+			ProductId: req.ProductId,
+			Postcode:  req.Postcode,
+			Status:    status,
+			Suppliers: supplierStockToProto(results),
+			Success:   status != "",
+			Message:   "Stock status retrieved successfully",
+		}
+		if status == "" {
+			resp.Message = "No stock provider could be reached"
+		}
+		return resp, nil
+	}
+
+	result, err := s.stock.StockStatus(ctx, req.Supplier, query)
 	if err != nil {
 		return &pb.GetStockStatusResponse{
 			ProductId: req.ProductId,
 			Postcode:  req.Postcode,
+			Supplier:  req.Supplier,
 			Success:   false,
 			Message:   err.Error(),
 		}, nil
@@ -146,21 +833,61 @@ func (s *server) GetStockStatus(ctx context.Context, req *pb.GetStockStatusReque
 	return &pb.GetStockStatusResponse{
 		ProductId: req.ProductId,
 		Postcode:  req.Postcode,
-		Status:    status,
+		Supplier:  result.Supplier,
+		Status:    result.Status,
+		Branches:  branchStockToProto(result.Branches),
+		Cache:     result.Cache,
 		Success:   true,
 		Message:   "Stock status retrieved successfully",
 	}, nil
 }
 
+// stockQueryFromProto converts a stock status request to a StockQuery
+func stockQueryFromProto(req *pb.GetStockStatusRequest) StockQuery {
+	return StockQuery{
+		ProductID:    req.ProductId,
+		Postcode:     req.Postcode,
+		BranchID:     req.BranchId,
+		BranchLimit:  int(req.BranchLimit),
+		WithinRadius: req.WithinRadius,
+	}
+}
+
+// supplierStockToProto converts fan-out results to protobuf messages
+func supplierStockToProto(results []SupplierStock) []*pb.SupplierStock {
+	out := make([]*pb.SupplierStock, len(results))
+	for i, result := range results {
+		out[i] = &pb.SupplierStock{
+			Supplier: result.Supplier,
+			Status:   result.Status,
+			Error:    result.Error,
+			Branches: branchStockToProto(result.Branches),
+			Cache:    result.Cache,
+		}
+	}
+	return out
+}
+
+// branchStockToProto converts branch stock to protobuf messages
+func branchStockToProto(branches []BranchStock) []*pb.BranchStock {
+	out := make([]*pb.BranchStock, len(branches))
+	for i, branch := range branches {
+		out[i] = &pb.BranchStock{BranchId: branch.BranchID, StockLevel: branch.StockLevel, StockUom: branch.StockUOM}
+	}
+	return out
+}
+
 // StartGRPCServer starts the gRPC server on the specified port
-func StartGRPCServer(port string) {
+func StartGRPCServer(port string, repo BeamRepository, beams *BeamService, events *BeamEventLog, sections *SectionCatalogue, stock *StockProviders) {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		log.Fatalf("failed to listen on port %s: %v", port, err)
 	}
 
 	grpcServer := grpc.NewServer()
-	pb.RegisterSteelBeamServiceServer(grpcServer, &server{})
+	legacy := &server{repo: repo, beams: beams, events: events, sections: sections, stock: stock}
+	pb.RegisterSteelBeamServiceServer(grpcServer, legacy)
+	steelbeamv1.RegisterSteelBeamServiceServer(grpcServer, &serverV1{legacy: legacy})
 
 	log.Printf("gRPC server starting on port %s", port)
 	if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "formandfunction-api/proto"
+	steelbeamv1 "formandfunction-api/proto/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// serverV1 implements steelbeam.v1.SteelBeamService. It shares its messages
+// and, where the original RPC already reports failures as status codes, its
+// implementation with server; the RPCs that returned found/success flags
+// are reimplemented here to return NotFound, InvalidArgument, Unavailable etc.
+type serverV1 struct {
+	steelbeamv1.UnimplementedSteelBeamServiceServer
+	legacy *server
+}
+
+// GetBeams returns beams, optionally filtered, sorted and paginated
+func (s *serverV1) GetBeams(ctx context.Context, req *pb.GetBeamsRequest) (*pb.GetBeamsResponse, error) {
+	return s.legacy.GetBeams(ctx, req)
+}
+
+// GetBeam returns a specific steel beam by section designation
+func (s *serverV1) GetBeam(ctx context.Context, req *pb.GetBeamRequest) (*pb.SteelBeam, error) {
+	log.Printf("gRPC v1 GetBeam called with section: %s", req.SectionDesignation)
+
+	units, err := ParseUnitSystem(req.Units)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	beam, err := s.legacy.repo.Get(req.SectionDesignation)
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+	return steelBeamToProto(ConvertBeam(beam, units)), nil
+}
+
+// CreateBeam creates a new steel beam
+func (s *serverV1) CreateBeam(ctx context.Context, req *pb.CreateBeamRequest) (*pb.SteelBeam, error) {
+	if req.Beam == nil {
+		return nil, invalidArgumentError(errors.New("beam is required"))
+	}
+	log.Printf("gRPC v1 CreateBeam called for section: %s", req.Beam.SectionDesignation)
+
+	beam := protoToSteelBeam(req.Beam)
+	if req.DeriveMissing {
+		derived, err := FillDerivedProperties(beam)
+		if err != nil {
+			return nil, beamError(req.Beam.SectionDesignation, err)
+		}
+		beam = derived
+	}
+	beam, err := s.legacy.beams.CreateBeam(beam)
+	if err != nil {
+		return nil, beamError(req.Beam.SectionDesignation, err)
+	}
+	return steelBeamToProto(beam), nil
+}
+
+// UpdateBeam replaces an existing beam
+func (s *serverV1) UpdateBeam(ctx context.Context, req *pb.UpdateBeamRequest) (*pb.SteelBeam, error) {
+	log.Printf("gRPC v1 UpdateBeam called for section: %s", req.SectionDesignation)
+
+	if req.Beam == nil {
+		return nil, invalidArgumentError(errors.New("beam is required"))
+	}
+	beam, err := s.legacy.beams.UpdateBeam(req.SectionDesignation, protoToSteelBeam(req.Beam))
+	if err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+	return steelBeamToProto(beam), nil
+}
+
+// DeleteBeam removes a beam
+func (s *serverV1) DeleteBeam(ctx context.Context, req *pb.DeleteBeamRequest) (*emptypb.Empty, error) {
+	log.Printf("gRPC v1 DeleteBeam called for section: %s", req.SectionDesignation)
+
+	if err := s.legacy.beams.DeleteBeam(req.SectionDesignation); err != nil {
+		return nil, beamError(req.SectionDesignation, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ImportBeams creates or replaces the streamed beams, reporting each result
+func (s *serverV1) ImportBeams(stream grpc.ClientStreamingServer[pb.ImportBeamsRequest, pb.ImportBeamsResponse]) error {
+	return s.legacy.ImportBeams(stream)
+}
+
+// WatchBeams streams catalogue changes made through either API
+func (s *serverV1) WatchBeams(req *pb.WatchBeamsRequest, stream grpc.ServerStreamingServer[pb.BeamEvent]) error {
+	return s.legacy.WatchBeams(req, stream)
+}
+
+// DeriveSectionProperties computes the section properties of an I-section from its geometry
+func (s *serverV1) DeriveSectionProperties(ctx context.Context, req *pb.DeriveSectionPropertiesRequest) (*pb.SteelBeam, error) {
+	resp, err := s.legacy.DeriveSectionProperties(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Beam, nil
+}
+
+// SelectBeam returns the lightest beams meeting the requested bounds
+func (s *serverV1) SelectBeam(ctx context.Context, req *pb.SelectBeamRequest) (*pb.SelectBeamResponse, error) {
+	return s.legacy.SelectBeam(ctx, req)
+}
+
+// CalculateBeamResistance classifies a beam and returns Mc,Rd and Vc,Rd
+func (s *serverV1) CalculateBeamResistance(ctx context.Context, req *pb.BeamResistanceRequest) (*pb.BeamResistanceResponse, error) {
+	return s.legacy.CalculateBeamResistance(ctx, req)
+}
+
+// CalculateLTBResistance returns the lateral-torsional buckling resistance of a beam segment
+func (s *serverV1) CalculateLTBResistance(ctx context.Context, req *pb.LTBRequest) (*pb.LTBResponse, error) {
+	return s.legacy.CalculateLTBResistance(ctx, req)
+}
+
+// DesignSimplySupported streams design check results for a simply-supported span
+func (s *serverV1) DesignSimplySupported(req *pb.SimplySupportedDesignRequest, stream grpc.ServerStreamingServer[pb.SectionDesignResult]) error {
+	return s.legacy.DesignSimplySupported(req, stream)
+}
+
+// GetMaterials returns steel grades and their strengths
+func (s *serverV1) GetMaterials(ctx context.Context, req *pb.GetMaterialsRequest) (*pb.GetMaterialsResponse, error) {
+	return s.legacy.GetMaterials(ctx, req)
+}
+
+// GetStockStatus returns stock status for a product at the default
+// merchant, the one named by supplier, or every merchant for "all"
+func (s *serverV1) GetStockStatus(ctx context.Context, req *pb.GetStockStatusRequest) (*steelbeamv1.StockStatus, error) {
+	log.Printf("gRPC v1 GetStockStatus called for product: %s, postcode: %s, supplier: %q", req.ProductId, req.Postcode, req.Supplier)
+
+	if req.ProductId == "" || req.Postcode == "" {
+		return nil, invalidArgumentError(errors.New("product_id and postcode are required"))
+	}
+	query := stockQueryFromProto(req)
+	if err := query.Validate(); err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	metadata := map[string]string{"product_id": req.ProductId, "postcode": req.Postcode}
+
+	if req.Supplier == AllStockProviders {
+		results := s.legacy.stock.FanOut(ctx, query)
+		status := MergeStockStatus(results)
+		if status == "" {
+			return nil, statusError(codes.Unavailable, reasonStockUnavailable, "no stock provider could be reached", metadata)
+		}
+		return &steelbeamv1.StockStatus{
+			ProductId: req.ProductId,
+			Postcode:  req.Postcode,
+			Status:    status,
+			Suppliers: supplierStockToProto(results),
+		}, nil
+	}
+
+	result, err := s.legacy.stock.StockStatus(ctx, req.Supplier, query)
+	if errors.Is(err, ErrUnknownStockProvider) {
+		return nil, invalidArgumentError(err)
+	}
+	if err != nil {
+		if req.Supplier != "" {
+			metadata["supplier"] = req.Supplier
+		}
+		return nil, statusError(codes.Unavailable, reasonStockUnavailable, err.Error(), metadata)
+	}
+	return &steelbeamv1.StockStatus{
+		ProductId: req.ProductId,
+		Postcode:  req.Postcode,
+		Supplier:  result.Supplier,
+		Status:    result.Status,
+		Branches:  branchStockToProto(result.Branches),
+		Cache:     result.Cache,
+	}, nil
+}
+
+// GetSections returns all sections, optionally filtered by family
+func (s *serverV1) GetSections(ctx context.Context, req *pb.GetSectionsRequest) (*pb.GetSectionsResponse, error) {
+	return s.legacy.GetSections(ctx, req)
+}
+
+// GetSection returns a section of any family by designation
+func (s *serverV1) GetSection(ctx context.Context, req *pb.GetSectionRequest) (*pb.Section, error) {
+	log.Printf("gRPC v1 GetSection called with section: %s", req.SectionDesignation)
+
+	section, err := s.legacy.sections.Get(req.SectionDesignation)
+	if err != nil {
+		return nil, sectionError(req.SectionDesignation, err)
+	}
+	return sectionToProto(section), nil
+}
@@ -1,9 +1,15 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"math"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -44,70 +50,11 @@ type SteelBeam struct {
 	AreaOfSection                float64 `json:"area_of_section"`
 }
 
-var beams = []SteelBeam{
-	{
-		SectionDesignation:           "UB406x178x74",
-		MassPerMetre:                 74.6,
-		DepthOfSection:               412.8,
-		WidthOfSection:               179.5,
-		ThicknessWeb:                 9.3,
-		ThicknessFlange:              16.0,
-		RootRadius:                   10.2,
-		DepthBetweenFillets:          360.8,
-		RatiosForLocalBucklingWeb:    38.8,
-		RatiosForLocalBucklingFlange: 5.61,
-		EndClearance:                 369.0,
-		Notch:                        360.8,
-		DimensionsForDetailingN:      45.0,
-		SurfaceAreaPerMetre:          1.17,
-		SurfaceAreaPerTonne:          15.7,
-		SecondMomentOfAreaAxisY:      27400,
-		SecondMomentOfAreaAxisZ:      1600,
-		RadiusOfGyrationAxisY:        17.1,
-		RadiusOfGyrationAxisZ:        4.22,
-		ElasticModulusAxisY:          1330,
-		ElasticModulusAxisZ:          178,
-		PlasticModulusAxisY:          1500,
-		PlasticModulusAxisZ:          275,
-		BucklingParameter:            0.338,
-		TorsionalIndex:               29.6,
-		WarpingConstant:              0.581,
-		TorsionalConstant:            53.8,
-		AreaOfSection:                95.0,
-	},
-	{
-		SectionDesignation:           "UB406x178x67",
-		MassPerMetre:                 67.1,
-		DepthOfSection:               406.4,
-		WidthOfSection:               177.9,
-		ThicknessWeb:                 8.6,
-		ThicknessFlange:              12.8,
-		RootRadius:                   10.2,
-		DepthBetweenFillets:          360.8,
-		RatiosForLocalBucklingWeb:    42.0,
-		RatiosForLocalBucklingFlange: 6.95,
-		EndClearance:                 362.6,
-		Notch:                        360.8,
-		DimensionsForDetailingN:      45.0,
-		SurfaceAreaPerMetre:          1.15,
-		SurfaceAreaPerTonne:          17.1,
-		SecondMomentOfAreaAxisY:      23500,
-		SecondMomentOfAreaAxisZ:      1350,
-		RadiusOfGyrationAxisY:        16.6,
-		RadiusOfGyrationAxisZ:        4.09,
-		ElasticModulusAxisY:          1160,
-		ElasticModulusAxisZ:          152,
-		PlasticModulusAxisY:          1300,
-		PlasticModulusAxisZ:          234,
-		BucklingParameter:            0.364,
-		TorsionalIndex:               25.4,
-		WarpingConstant:              0.424,
-		TorsionalConstant:            36.4,
-		AreaOfSection:                85.5,
-	},
-}
-
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "audit" {
+		os.Exit(runAuditCommand(os.Args[2:]))
+	}
+
 	// Configuration
 	httpPort := os.Getenv("PORT")
 	if httpPort == "" {
@@ -119,6 +66,40 @@ func main() {
 		grpcPort = "9090"
 	}
 
+	seed, err := LoadDefaultBeams()
+	if err != nil {
+		log.Fatalf("Failed to load built-in section tables: %v", err)
+	}
+	log.Printf("Loaded %d built-in beam sections", len(seed))
+
+	repo, err := NewBeamRepositoryFromEnv(seed)
+	if err != nil {
+		log.Fatalf("Failed to open beam repository: %v", err)
+	}
+	tables, err := LoadSectionTables()
+	if err != nil {
+		log.Fatalf("Failed to load section family tables: %v", err)
+	}
+	sections := NewSectionCatalogue(repo, tables)
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	beamService := NewBeamService(repo, events)
+	stock := NewDefaultStockProviders()
+	log.Printf("Stock providers: %s", strings.Join(stock.Names(), ", "))
+	stockCache, err := NewStockCacheFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure stock cache: %v", err)
+	}
+	stock.SetCache(stockCache)
+	log.Printf("Stock cache TTL: %s (NotAvailable: %s)", stockCache.TTL, stockCache.NegativeTTL)
+	handlers := &httpHandlers{
+		repo:     repo,
+		beams:    beamService,
+		events:   events,
+		sections: sections,
+		stock:    stock,
+		closing:  make(chan struct{}),
+	}
+
 	// Create Fiber app for HTTP REST API (frontend consumption)
 	app := fiber.New(fiber.Config{
 		ReadBufferSize:  32768,            // Increase to 32KB to handle large headers
@@ -154,27 +135,62 @@ func main() {
 			"version":     "2.0.0",
 			"description": "HTTP REST API for frontend + gRPC backend communication",
 			"endpoints": []string{
-				"GET /beams",
-				"GET /beams/:sectionDesignation",
-				"POST /beams",
+				"GET /beams?min_<field>=<n>&max_<field>=<n>&sort=<field>&order=asc|desc&offset=<n>&limit=<n>&fields=<field>,...&format=json|csv|xlsx|ndjson&units=catalogue|si|mm|imperial",
+				"GET /beams/events",
+				"GET /beams/audit?tolerance=<percent>",
+				"GET /beams/schema?units=catalogue|si|mm|imperial",
+				"GET /beams/:sectionDesignation?units=catalogue|si|mm|imperial",
+				"POST /beams?derive=true",
+				"POST /beams/select",
+				"POST /beams/derive",
+				"POST /beams/import?mode=insert|upsert&dry_run=true&derive=true",
+				"POST /beams/:sectionDesignation/resistance",
+				"POST /beams/:sectionDesignation/ltb",
+				"POST /design/simply-supported",
 				"PUT /beams/:sectionDesignation",
 				"DELETE /beams/:sectionDesignation",
-				"GET /stock?productId=<product_id>&postcode=<postcode>",
+				"GET /sections?family=<family>",
+				"GET /sections/families",
+				"GET /sections/:sectionDesignation",
+				"GET /materials?thickness=<mm>",
+				"GET /materials/:grade?thickness=<mm>",
+				"GET /stock?productId=<product_id>&postcode=<postcode>&supplier=<name>|all&branchId=<id>&branchLimit=<n>&withinRadius=<miles>",
 			},
 			"grpc_port": grpcPort,
 			"http_port": httpPort,
 		})
 	})
 
-	app.Get("/beams", getBeams)
-	app.Get("/beams/:sectionDesignation", getBeam)
-	app.Post("/beams", createBeam)
-	app.Put("/beams/:sectionDesignation", updateBeam)
-	app.Delete("/beams/:sectionDesignation", deleteBeam)
-	app.Get("/stock", getStockStatusHandler)
+	app.Get("/beams", handlers.getBeams)
+	app.Get("/beams/events", handlers.beamEvents)
+	app.Get("/beams/audit", handlers.auditBeams)
+	app.Get("/beams/schema", getBeamSchema)
+	app.Get("/beams/:sectionDesignation", handlers.getBeam)
+	app.Post("/beams", handlers.createBeam)
+	app.Post("/beams/select", handlers.selectBeam)
+	app.Post("/beams/derive", deriveBeamHandler)
+	app.Post("/beams/import", handlers.importBeams)
+	app.Post("/beams/:sectionDesignation/resistance", handlers.beamResistance)
+	app.Post("/beams/:sectionDesignation/ltb", handlers.beamLTBResistance)
+	app.Post("/design/simply-supported", handlers.designSimplySupported)
+	app.Put("/beams/:sectionDesignation", handlers.updateBeam)
+	app.Delete("/beams/:sectionDesignation", handlers.deleteBeam)
+	app.Get("/sections", handlers.getSections)
+	app.Get("/sections/families", handlers.getSectionFamilies)
+	app.Get("/sections/:sectionDesignation", handlers.getSection)
+	app.Get("/materials", getMaterialsHandler)
+	app.Get("/materials/:grade", getMaterialHandler)
+	app.Get("/stock", handlers.getStockStatus)
 
 	// Health check endpoint
 	app.Get("/health", func(c *fiber.Ctx) error {
+		beams, err := repo.List()
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"status": "unhealthy",
+				"error":  err.Error(),
+			})
+		}
 		return c.JSON(fiber.Map{
 			"status":       "healthy",
 			"service":      "Form & Function API",
@@ -193,7 +209,7 @@ func main() {
 	// Start gRPC server in a goroutine (for backend services like Python calc engine)
 	go func() {
 		log.Printf("Starting gRPC server on port %s (for backend services)", grpcPort)
-		StartGRPCServer(grpcPort)
+		StartGRPCServer(grpcPort, repo, beamService, events, sections, stock)
 	}()
 
 	// Start HTTP REST API server in a goroutine (for frontend)
@@ -215,6 +231,7 @@ func main() {
 
 	// Graceful shutdown
 	log.Println("Shutting down HTTP server...")
+	close(handlers.closing)
 	if err := app.Shutdown(); err != nil {
 		log.Printf("Error during HTTP server shutdown: %v", err)
 	}
@@ -224,7 +241,21 @@ func main() {
 
 // HTTP REST API Handlers for Frontend
 
-func getStockStatusHandler(c *fiber.Ctx) error {
+// httpHandlers serves the beam routes, reading from a BeamRepository and
+// writing through the BeamService shared with the gRPC server
+type httpHandlers struct {
+	repo     BeamRepository
+	beams    *BeamService
+	events   *BeamEventLog
+	sections *SectionCatalogue
+	stock    *StockProviders
+	// closing is closed on shutdown to end long-lived event streams
+	closing chan struct{}
+}
+
+// getStockStatus checks stock at the default merchant, at the one named
+// by supplier, or with supplier=all at every registered merchant at once
+func (h *httpHandlers) getStockStatus(c *fiber.Ctx) error {
 	productID := c.Query("productId")
 	if productID == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "productId query parameter is required"})
@@ -233,8 +264,54 @@ func getStockStatusHandler(c *fiber.Ctx) error {
 	if postcode == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "postcode query parameter is required"})
 	}
+	supplier := c.Query("supplier")
+	query := StockQuery{ProductID: productID, Postcode: postcode, BranchID: c.Query("branchId")}
+	if value := c.Query("branchLimit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "branchLimit must be a non-negative integer"})
+		}
+		query.BranchLimit = limit
+	}
+	if value := c.Query("withinRadius"); value != "" {
+		radius, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "withinRadius must be a non-negative number of miles"})
+		}
+		query.WithinRadius = radius
+	}
+	if err := query.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
 
-	status, err := GetStockStatus(productID, postcode)
+	// fasthttp's request context is only cancelled at shutdown, so each
+	// lookup gets a deadline of its own
+	ctx, cancel := context.WithTimeout(c.UserContext(), stockProviderTimeout)
+	defer cancel()
+
+	if supplier == AllStockProviders {
+		results := h.stock.FanOut(ctx, query)
+		status := MergeStockStatus(results)
+		code := fiber.StatusOK
+		if status == "" {
+			code = fiber.StatusBadGateway
+		}
+		return c.Status(code).JSON(fiber.Map{
+			"productId": productID,
+			"postcode":  postcode,
+			"status":    status,
+			"suppliers": results,
+			"source":    "http_rest_api",
+		})
+	}
+
+	result, err := h.stock.StockStatus(ctx, supplier, query)
+	if errors.Is(err, ErrUnknownStockProvider) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if errors.Is(err, ErrSupplierCircuitOpen) {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -242,39 +319,195 @@ func getStockStatusHandler(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{
 		"productId": productID,
 		"postcode":  postcode,
-		"status":    status,
+		"supplier":  result.Supplier,
+		"status":    result.Status,
+		"branches":  result.Branches,
+		"cache":     result.Cache,
 		"source":    "http_rest_api",
 	})
 }
 
-func getBeams(c *fiber.Ctx) error {
-	log.Printf("HTTP REST API: GET /beams called")
+// auditBeams recomputes every stored beam's properties from its dimensions
+// and reports the values deviating by more than the tolerance
+func (h *httpHandlers) auditBeams(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /beams/audit called")
+
+	tolerance := defaultAuditTolerance
+	if value := c.Query("tolerance"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":  "tolerance must be a non-negative percentage",
+				"source": "http_rest_api",
+			})
+		}
+		tolerance = parsed
+	}
+
+	beams, err := h.repo.List()
+	if err != nil {
+		return repositoryError(c, err)
+	}
 	return c.JSON(fiber.Map{
-		"beams":  beams,
-		"count":  len(beams),
+		"audit":  AuditBeams(beams, tolerance),
 		"source": "http_rest_api",
 	})
 }
 
-func getBeam(c *fiber.Ctx) error {
+func (h *httpHandlers) getBeams(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /beams called")
+
+	query, err := ParseBeamQuery(c.Queries())
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	format, status, err := negotiateBeamFormat(c)
+	if err != nil {
+		return c.Status(status).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	units, err := ParseUnitSystem(c.Query("units"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beams, err := h.repo.List()
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	page := query.InUnits(units).Apply(beams)
+	page.Beams = ConvertBeams(page.Beams, units)
+
+	if format != BeamFormatJSON {
+		return writeBeamExport(c, format, page, query.Columns())
+	}
+
+	response := fiber.Map{
+		"beams":  page.Beams,
+		"count":  len(page.Beams),
+		"total":  page.Total,
+		"offset": query.Offset,
+		"limit":  query.Limit,
+		"units":  units,
+		"source": "http_rest_api",
+	}
+	if len(query.Fields) > 0 {
+		response["beams"] = projectBeams(page.Beams, query.Columns())
+	}
+	if page.NextOffset > 0 {
+		response["next_offset"] = page.NextOffset
+	}
+	return c.JSON(response)
+}
+
+// negotiateBeamFormat picks the representation for GET /beams: the format
+// query parameter if given, otherwise the best match for the Accept header.
+// On failure it also returns the status code to respond with.
+func negotiateBeamFormat(c *fiber.Ctx) (BeamFormat, int, error) {
+	c.Vary(fiber.HeaderAccept)
+	if value := c.Query("format"); value != "" {
+		format, err := ParseBeamFormat(value)
+		if err != nil {
+			return "", fiber.StatusBadRequest, err
+		}
+		return format, 0, nil
+	}
+
+	offers := make([]string, len(beamFormatTypes))
+	for i, t := range beamFormatTypes {
+		offers[i] = t.MediaType
+	}
+	accepted := c.Accepts(offers...)
+	for _, t := range beamFormatTypes {
+		if t.MediaType == accepted {
+			return t.Format, 0, nil
+		}
+	}
+	return "", fiber.StatusNotAcceptable,
+		errors.New("Accept must allow application/json, text/csv, application/x-ndjson or the XLSX media type")
+}
+
+// writeBeamExport sends a page of beams as a CSV, XLSX or NDJSON download.
+// Pagination is reported in X-Total-Count and X-Next-Offset headers.
+func writeBeamExport(c *fiber.Ctx, format BeamFormat, page BeamPage, columns []beamColumn) error {
+	c.Set(fiber.HeaderContentType, beamFormatMediaType(format))
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="beams.%s"`, format))
+	c.Set("X-Total-Count", strconv.Itoa(page.Total))
+	if page.NextOffset > 0 {
+		c.Set("X-Next-Offset", strconv.Itoa(page.NextOffset))
+	}
+
+	var err error
+	w := c.Response().BodyWriter()
+	switch format {
+	case BeamFormatCSV:
+		err = WriteBeamsCSV(w, page.Beams, columns)
+	case BeamFormatXLSX:
+		err = WriteBeamsXLSX(w, page.Beams, columns)
+	case BeamFormatNDJSON:
+		err = WriteBeamsNDJSON(w, page.Beams, columns)
+	}
+	if err != nil {
+		log.Printf("HTTP REST API: failed to export beams as %s: %v", format, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return nil
+}
+
+func (h *httpHandlers) getBeam(c *fiber.Ctx) error {
 	sectionDesignation := c.Params("sectionDesignation")
 	log.Printf("HTTP REST API: GET /beams/%s called", sectionDesignation)
 
-	for _, beam := range beams {
-		if beam.SectionDesignation == sectionDesignation {
-			return c.JSON(fiber.Map{
-				"beam":   beam,
-				"source": "http_rest_api",
-			})
-		}
+	units, err := ParseUnitSystem(c.Query("units"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	beam, err := h.repo.Get(sectionDesignation)
+	if err != nil {
+		return repositoryError(c, err)
 	}
-	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-		"error":  "Beam not found",
+	return c.JSON(fiber.Map{
+		"beam":   ConvertBeam(beam, units),
+		"units":  units,
 		"source": "http_rest_api",
 	})
 }
 
-func createBeam(c *fiber.Ctx) error {
+// getBeamSchema documents every SteelBeam field with its unit in the
+// requested system
+func getBeamSchema(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /beams/schema called")
+
+	units, err := ParseUnitSystem(c.Query("units"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(fiber.Map{
+		"units":        units,
+		"unit_systems": unitSystems,
+		"fields":       BeamSchema(units),
+		"source":       "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) createBeam(c *fiber.Ctx) error {
 	log.Printf("HTTP REST API: POST /beams called")
 
 	beam := new(SteelBeam)
@@ -285,15 +518,179 @@ func createBeam(c *fiber.Ctx) error {
 		})
 	}
 
-	beams = append(beams, *beam)
+	if c.QueryBool("derive") {
+		derived, err := FillDerivedProperties(*beam)
+		if err != nil {
+			return repositoryError(c, err)
+		}
+		*beam = derived
+	}
+
+	created, err := h.beams.CreateBeam(*beam)
+	if err != nil {
+		return repositoryError(c, err)
+	}
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"beam":    beam,
+		"beam":    created,
 		"message": "Beam created successfully",
 		"source":  "http_rest_api",
 	})
 }
 
-func updateBeam(c *fiber.Ctx) error {
+// deriveBeamHandler computes the section properties of an I-section from
+// its depth, width, web and flange thicknesses and root radius
+func deriveBeamHandler(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: POST /beams/derive called")
+
+	geometry := new(SectionGeometry)
+	if err := c.BodyParser(geometry); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beam, err := DeriveSectionProperties(*geometry)
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	return c.JSON(fiber.Map{
+		"beam":   beam,
+		"source": "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) selectBeam(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: POST /beams/select called")
+
+	selection := new(BeamSelection)
+	if err := c.BodyParser(selection); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beams, err := h.repo.List()
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	candidates, err := SelectBeams(beams, *selection)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(fiber.Map{
+		"candidates": candidates,
+		"count":      len(candidates),
+		"source":     "http_rest_api",
+	})
+}
+
+// resistanceRequest is the body of POST /beams/:sectionDesignation/resistance
+type resistanceRequest struct {
+	SteelGrade string `json:"steel_grade"`
+}
+
+func (h *httpHandlers) beamResistance(c *fiber.Ctx) error {
+	sectionDesignation := c.Params("sectionDesignation")
+	log.Printf("HTTP REST API: POST /beams/%s/resistance called", sectionDesignation)
+
+	req := new(resistanceRequest)
+	if err := c.BodyParser(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beam, err := h.repo.Get(sectionDesignation)
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	resistance, err := CalculateBeamResistance(beam, req.SteelGrade)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(fiber.Map{
+		"resistance": resistance,
+		"source":     "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) beamLTBResistance(c *fiber.Ctx) error {
+	sectionDesignation := c.Params("sectionDesignation")
+	log.Printf("HTTP REST API: POST /beams/%s/ltb called", sectionDesignation)
+
+	input := new(LTBInput)
+	if err := c.BodyParser(input); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beam, err := h.repo.Get(sectionDesignation)
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	resistance, err := CalculateLTBResistance(beam, *input)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(fiber.Map{
+		"ltb":    resistance,
+		"source": "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) designSimplySupported(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: POST /design/simply-supported called")
+
+	design := new(SimplySupportedDesign)
+	if err := c.BodyParser(design); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	beams, err := h.repo.List()
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	results := []SectionDesignResult{}
+	passing := 0
+	err = DesignSimplySupported(beams, *design, func(result SectionDesignResult) error {
+		results = append(results, result)
+		if result.Passes {
+			passing++
+		}
+		return nil
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(fiber.Map{
+		"results": results,
+		"count":   len(results),
+		"passing": passing,
+		"source":  "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) updateBeam(c *fiber.Ctx) error {
 	sectionDesignation := c.Params("sectionDesignation")
 	log.Printf("HTTP REST API: PUT /beams/%s called", sectionDesignation)
 
@@ -305,39 +702,191 @@ func updateBeam(c *fiber.Ctx) error {
 		})
 	}
 
-	for i, beam := range beams {
-		if beam.SectionDesignation == sectionDesignation {
-			beams[i] = *beamUpdate
-			return c.JSON(fiber.Map{
-				"beam":    beamUpdate,
-				"message": "Beam updated successfully",
-				"source":  "http_rest_api",
-			})
-		}
+	updated, err := h.beams.UpdateBeam(sectionDesignation, *beamUpdate)
+	if err != nil {
+		return repositoryError(c, err)
 	}
-
-	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-		"error":  "Beam not found",
-		"source": "http_rest_api",
+	return c.JSON(fiber.Map{
+		"beam":    updated,
+		"message": "Beam updated successfully",
+		"source":  "http_rest_api",
 	})
 }
 
-func deleteBeam(c *fiber.Ctx) error {
+func (h *httpHandlers) deleteBeam(c *fiber.Ctx) error {
 	sectionDesignation := c.Params("sectionDesignation")
 	log.Printf("HTTP REST API: DELETE /beams/%s called", sectionDesignation)
 
-	for i, beam := range beams {
-		if beam.SectionDesignation == sectionDesignation {
-			beams = append(beams[:i], beams[i+1:]...)
-			return c.Status(fiber.StatusNoContent).JSON(fiber.Map{
-				"message": "Beam deleted successfully",
-				"source":  "http_rest_api",
+	if err := h.beams.DeleteBeam(sectionDesignation); err != nil {
+		return repositoryError(c, err)
+	}
+	return c.Status(fiber.StatusNoContent).JSON(fiber.Map{
+		"message": "Beam deleted successfully",
+		"source":  "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) getSections(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /sections called")
+
+	var family SectionFamily
+	if code := c.Query("family"); code != "" {
+		var err error
+		if family, err = ParseSectionFamily(code); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":  err.Error(),
+				"source": "http_rest_api",
 			})
 		}
 	}
 
-	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-		"error":  "Beam not found",
+	sections, err := h.sections.List(family)
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	return c.JSON(fiber.Map{
+		"sections": sections,
+		"count":    len(sections),
+		"source":   "http_rest_api",
+	})
+}
+
+func (h *httpHandlers) getSectionFamilies(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /sections/families called")
+
+	return c.JSON(fiber.Map{
+		"families": sectionFamilies,
+		"source":   "http_rest_api",
+	})
+}
+
+// materialThickness parses the optional thickness query parameter in mm
+func materialThickness(c *fiber.Ctx) (float64, bool, error) {
+	value := c.Query("thickness")
+	if value == "" {
+		return 0, false, nil
+	}
+	thickness, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, errors.New("thickness must be a number")
+	}
+	return thickness, true, nil
+}
+
+func getMaterialsHandler(c *fiber.Ctx) error {
+	log.Printf("HTTP REST API: GET /materials called")
+
+	thickness, ok, err := materialThickness(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	materials := ListMaterials()
+	response := fiber.Map{
+		"materials": materials,
+		"count":     len(materials),
+		"source":    "http_rest_api",
+	}
+	if ok {
+		strengths := make([]MaterialStrength, 0, len(materials))
+		for _, material := range materials {
+			strength, err := material.Strength(thickness)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":  err.Error(),
+					"source": "http_rest_api",
+				})
+			}
+			strengths = append(strengths, strength)
+		}
+		response["strengths"] = strengths
+	}
+	return c.JSON(response)
+}
+
+func getMaterialHandler(c *fiber.Ctx) error {
+	grade := c.Params("grade")
+	log.Printf("HTTP REST API: GET /materials/%s called", grade)
+
+	material, err := LookupMaterial(grade)
+	if errors.Is(err, ErrUnknownSteelGrade) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":  "Material not found",
+			"source": "http_rest_api",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+
+	response := fiber.Map{
+		"material": material,
+		"source":   "http_rest_api",
+	}
+	thickness, ok, err := materialThickness(c)
+	if err == nil && ok {
+		response["strength"], err = material.Strength(thickness)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  err.Error(),
+			"source": "http_rest_api",
+		})
+	}
+	return c.JSON(response)
+}
+
+func (h *httpHandlers) getSection(c *fiber.Ctx) error {
+	sectionDesignation := c.Params("sectionDesignation")
+	log.Printf("HTTP REST API: GET /sections/%s called", sectionDesignation)
+
+	section, err := h.sections.Get(sectionDesignation)
+	if errors.Is(err, ErrSectionNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":  "Section not found",
+			"source": "http_rest_api",
+		})
+	}
+	if err != nil {
+		return repositoryError(c, err)
+	}
+	return c.JSON(fiber.Map{
+		"section": section,
+		"source":  "http_rest_api",
+	})
+}
+
+// repositoryError maps a BeamRepository or BeamService error onto an HTTP response
+func repositoryError(c *fiber.Ctx, err error) error {
+	var invalid *ValidationError
+	switch {
+	case errors.Is(err, ErrBeamNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":  "Beam not found",
+			"source": "http_rest_api",
+		})
+	case errors.Is(err, ErrBeamExists):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":  "Beam already exists",
+			"errors": []FieldError{{Field: "section_designation", Message: "already exists"}},
+			"source": "http_rest_api",
+		})
+	case errors.As(err, &invalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":  "Validation failed",
+			"errors": invalid.Errors,
+			"source": "http_rest_api",
+		})
+	}
+	log.Printf("HTTP REST API: beam repository error: %v", err)
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error":  err.Error(),
 		"source": "http_rest_api",
 	})
 }
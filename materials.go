@@ -0,0 +1,152 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Elastic properties common to all structural steels (EN 1993-1-1 3.2.6)
+const (
+	youngsModulus         = 210000.0 // E in N/mm²
+	shearModulus          = 81000.0  // G in N/mm²
+	poissonsRatio         = 0.3
+	steelDensity          = 7850.0 // kg/m³
+	steelThermalExpansion = 12e-6  // per °C
+)
+
+// ErrUnknownSteelGrade is returned when a grade name matches no material
+var ErrUnknownSteelGrade = errors.New("unknown steel grade")
+
+// StrengthBand is a nominal strength in N/mm² for elements up to MaxThickness
+// mm thick. ExclusiveMax marks a band for elements thinner than MaxThickness,
+// such as the t < 3 mm band of Rm in EN 10025-2.
+type StrengthBand struct {
+	MaxThickness float64 `json:"max_thickness"`
+	ExclusiveMax bool    `json:"exclusive_max,omitempty"`
+	Value        float64 `json:"value"`
+}
+
+// upTo is a band for elements at most thickness mm thick
+func upTo(thickness, value float64) StrengthBand {
+	return StrengthBand{MaxThickness: thickness, Value: value}
+}
+
+// below is a band for elements less than thickness mm thick
+func below(thickness, value float64) StrengthBand {
+	return StrengthBand{MaxThickness: thickness, ExclusiveMax: true, Value: value}
+}
+
+// SteelMaterial is a structural steel grade with its thickness-dependent
+// nominal strengths. Bands are ordered by MaxThickness; an element uses the
+// first band whose upper bound it does not exceed.
+type SteelMaterial struct {
+	Grade            string         `json:"grade"`
+	Standard         string         `json:"standard"`
+	Description      string         `json:"description"`
+	YieldStrength    []StrengthBand `json:"yield_strength"`
+	UltimateStrength []StrengthBand `json:"ultimate_strength"`
+	ElasticModulus   float64        `json:"elastic_modulus"`
+	ShearModulus     float64        `json:"shear_modulus"`
+	PoissonsRatio    float64        `json:"poissons_ratio"`
+	Density          float64        `json:"density"`
+	ThermalExpansion float64        `json:"thermal_expansion"`
+}
+
+// MaterialStrength is the fy and fu of a grade at a given thickness, in mm and N/mm²
+type MaterialStrength struct {
+	Grade            string  `json:"grade"`
+	Thickness        float64 `json:"thickness"`
+	YieldStrength    float64 `json:"yield_strength"`
+	UltimateStrength float64 `json:"ultimate_strength"`
+}
+
+// steelMaterials holds ReH and the minimum Rm from EN 10025-2 (non-alloy
+// grades) and EN 10025-3 (S460N), as the UK National Annex requires
+var steelMaterials = []SteelMaterial{
+	newSteelMaterial("S235", "EN 10025-2", "Non-alloy structural steel",
+		[]StrengthBand{upTo(16, 235), upTo(40, 225), upTo(63, 215), upTo(80, 215), upTo(100, 215), upTo(150, 195)},
+		[]StrengthBand{below(3, 360), upTo(100, 360), upTo(150, 350)}),
+	newSteelMaterial("S275", "EN 10025-2", "Non-alloy structural steel",
+		[]StrengthBand{upTo(16, 275), upTo(40, 265), upTo(63, 255), upTo(80, 245), upTo(100, 235), upTo(150, 225)},
+		[]StrengthBand{below(3, 430), upTo(100, 410), upTo(150, 400)}),
+	newSteelMaterial("S355", "EN 10025-2", "Non-alloy structural steel",
+		[]StrengthBand{upTo(16, 355), upTo(40, 345), upTo(63, 335), upTo(80, 325), upTo(100, 315), upTo(150, 295)},
+		[]StrengthBand{below(3, 510), upTo(100, 470), upTo(150, 450)}),
+	newSteelMaterial("S460", "EN 10025-3", "Normalized fine grain structural steel",
+		[]StrengthBand{upTo(16, 460), upTo(40, 440), upTo(63, 430), upTo(80, 410), upTo(100, 400), upTo(150, 380)},
+		[]StrengthBand{upTo(100, 540), upTo(150, 530)}),
+}
+
+func newSteelMaterial(grade, standard, description string, fy, fu []StrengthBand) SteelMaterial {
+	return SteelMaterial{
+		Grade:            grade,
+		Standard:         standard,
+		Description:      description,
+		YieldStrength:    fy,
+		UltimateStrength: fu,
+		ElasticModulus:   youngsModulus,
+		ShearModulus:     shearModulus,
+		PoissonsRatio:    poissonsRatio,
+		Density:          steelDensity,
+		ThermalExpansion: steelThermalExpansion,
+	}
+}
+
+// ListMaterials returns every supported steel grade
+func ListMaterials() []SteelMaterial {
+	return steelMaterials
+}
+
+// LookupMaterial finds a grade by name. Names are case-insensitive and may
+// carry a quality or delivery suffix, so "s355j2" and "S460N" resolve to
+// S355 and S460.
+func LookupMaterial(name string) (SteelMaterial, error) {
+	if name == "" {
+		return SteelMaterial{}, errors.New("steel_grade is required")
+	}
+	normalised := strings.ToUpper(strings.TrimSpace(name))
+	for _, material := range steelMaterials {
+		suffix, ok := strings.CutPrefix(normalised, material.Grade)
+		if ok && (suffix == "" || suffix[0] >= 'A' && suffix[0] <= 'Z') {
+			return material, nil
+		}
+	}
+	return SteelMaterial{}, fmt.Errorf("%w %q", ErrUnknownSteelGrade, name)
+}
+
+// Strength returns fy and fu for an element of the given thickness in mm
+func (m SteelMaterial) Strength(thickness float64) (MaterialStrength, error) {
+	if thickness < 0 {
+		return MaterialStrength{}, errors.New("thickness must not be negative")
+	}
+	fy, okY := strengthAt(m.YieldStrength, thickness)
+	fu, okU := strengthAt(m.UltimateStrength, thickness)
+	if !okY || !okU {
+		limit := m.YieldStrength[len(m.YieldStrength)-1].MaxThickness
+		return MaterialStrength{}, fmt.Errorf("thickness %.1f mm exceeds the %.0f mm limit of the %s strength table", thickness, limit, m.Grade)
+	}
+	return MaterialStrength{Grade: m.Grade, Thickness: thickness, YieldStrength: fy, UltimateStrength: fu}, nil
+}
+
+func strengthAt(bands []StrengthBand, thickness float64) (float64, bool) {
+	for _, band := range bands {
+		if thickness < band.MaxThickness || thickness == band.MaxThickness && !band.ExclusiveMax {
+			return band.Value, true
+		}
+	}
+	return 0, false
+}
+
+// yieldStrength returns the nominal fy of grade for an element of the given thickness in mm
+func yieldStrength(grade string, thickness float64) (float64, error) {
+	material, err := LookupMaterial(grade)
+	if err != nil {
+		return 0, err
+	}
+	strength, err := material.Strength(thickness)
+	if err != nil {
+		return 0, err
+	}
+	return strength.YieldStrength, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+// Band edges from EN 10025-2 Tables 7 and 8: ReH bands are t ≤ 16, 16 < t ≤
+// 40, ...; Rm bands are t < 3, 3 ≤ t ≤ 100 and 100 < t ≤ 150
+func TestMaterialStrengthBandEdges(t *testing.T) {
+	tests := []struct {
+		grade     string
+		thickness float64
+		fy, fu    float64
+	}{
+		{"S355", 2.9, 355, 510},
+		{"S355", 3, 355, 470},
+		{"S355", 16, 355, 470},
+		{"S355", 16.1, 345, 470},
+		{"S355", 100, 315, 470},
+		{"S355", 100.1, 295, 450},
+		{"S355", 150, 295, 450},
+		{"S275", 3, 275, 410},
+		{"S235", 0, 235, 360},
+		{"S460", 3, 460, 540},
+	}
+	for _, tt := range tests {
+		material, err := LookupMaterial(tt.grade)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := material.Strength(tt.thickness)
+		if err != nil {
+			t.Errorf("%s at %g mm: %v", tt.grade, tt.thickness, err)
+			continue
+		}
+		if got.YieldStrength != tt.fy || got.UltimateStrength != tt.fu {
+			t.Errorf("%s at %g mm: fy %g, fu %g; want %g, %g", tt.grade, tt.thickness,
+				got.YieldStrength, got.UltimateStrength, tt.fy, tt.fu)
+		}
+	}
+}
+
+func TestMaterialStrengthRejectsThickness(t *testing.T) {
+	material, err := LookupMaterial("S355")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, thickness := range []float64{-1, 150.1} {
+		if _, err := material.Strength(thickness); err == nil {
+			t.Errorf("Strength(%g): expected an error", thickness)
+		}
+	}
+}
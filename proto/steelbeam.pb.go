@@ -2,13 +2,14 @@
 // versions:
 // 	protoc-gen-go v1.36.8
 // 	protoc        v5.29.3
-// source: proto/steelbeam.proto
+// source: steelbeam.proto
 
 package steelbeam
 
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -58,7 +59,7 @@ type SteelBeam struct {
 
 func (x *SteelBeam) Reset() {
 	*x = SteelBeam{}
-	mi := &file_proto_steelbeam_proto_msgTypes[0]
+	mi := &file_steelbeam_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -70,7 +71,7 @@ func (x *SteelBeam) String() string {
 func (*SteelBeam) ProtoMessage() {}
 
 func (x *SteelBeam) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[0]
+	mi := &file_steelbeam_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -83,7 +84,7 @@ func (x *SteelBeam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SteelBeam.ProtoReflect.Descriptor instead.
 func (*SteelBeam) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{0}
+	return file_steelbeam_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *SteelBeam) GetSectionDesignation() string {
@@ -282,16 +283,86 @@ func (x *SteelBeam) GetAreaOfSection() float64 {
 	return 0
 }
 
-// Request message to get all beams
-type GetBeamsRequest struct {
+// Range filter on a numeric SteelBeam field, named by its JSON tag
+type BeamRangeFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Min           *float64               `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeamRangeFilter) Reset() {
+	*x = BeamRangeFilter{}
+	mi := &file_steelbeam_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeamRangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeamRangeFilter) ProtoMessage() {}
+
+func (x *BeamRangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeamRangeFilter.ProtoReflect.Descriptor instead.
+func (*BeamRangeFilter) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BeamRangeFilter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *BeamRangeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *BeamRangeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Request message to get beams, optionally filtered, sorted and paginated.
+// An empty request returns every beam.
+type GetBeamsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Filters    []*BeamRangeFilter     `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	SortBy     string                 `protobuf:"bytes,2,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Descending bool                   `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	Offset     int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of beams to return; zero returns every match
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// "catalogue" (default), "si", "mm" or "imperial"; filters are in the same units
+	Units         string `protobuf:"bytes,6,opt,name=units,proto3" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBeamsRequest) Reset() {
 	*x = GetBeamsRequest{}
-	mi := &file_proto_steelbeam_proto_msgTypes[1]
+	mi := &file_steelbeam_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +374,7 @@ func (x *GetBeamsRequest) String() string {
 func (*GetBeamsRequest) ProtoMessage() {}
 
 func (x *GetBeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[1]
+	mi := &file_steelbeam_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,20 +387,66 @@ func (x *GetBeamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBeamsRequest.ProtoReflect.Descriptor instead.
 func (*GetBeamsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{1}
+	return file_steelbeam_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBeamsRequest) GetFilters() []*BeamRangeFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetBeamsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetBeamsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetBeamsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetBeamsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetBeamsRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
 }
 
 // Response message containing list of beams
 type GetBeamsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Beams         []*SteelBeam           `protobuf:"bytes,1,rep,name=beams,proto3" json:"beams,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Beams []*SteelBeam           `protobuf:"bytes,1,rep,name=beams,proto3" json:"beams,omitempty"`
+	// Number of beams matching the filters before pagination
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Offset of the next page, or zero on the last page
+	NextOffset    int32 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBeamsResponse) Reset() {
 	*x = GetBeamsResponse{}
-	mi := &file_proto_steelbeam_proto_msgTypes[2]
+	mi := &file_steelbeam_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -341,7 +458,7 @@ func (x *GetBeamsResponse) String() string {
 func (*GetBeamsResponse) ProtoMessage() {}
 
 func (x *GetBeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[2]
+	mi := &file_steelbeam_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +471,7 @@ func (x *GetBeamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBeamsResponse.ProtoReflect.Descriptor instead.
 func (*GetBeamsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{2}
+	return file_steelbeam_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetBeamsResponse) GetBeams() []*SteelBeam {
@@ -364,17 +481,33 @@ func (x *GetBeamsResponse) GetBeams() []*SteelBeam {
 	return nil
 }
 
+func (x *GetBeamsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetBeamsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
 // Request message to get a specific beam by section designation
 type GetBeamRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// "catalogue" (default), "si", "mm" or "imperial"
+	Units         string `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBeamRequest) Reset() {
 	*x = GetBeamRequest{}
-	mi := &file_proto_steelbeam_proto_msgTypes[3]
+	mi := &file_steelbeam_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +519,7 @@ func (x *GetBeamRequest) String() string {
 func (*GetBeamRequest) ProtoMessage() {}
 
 func (x *GetBeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[3]
+	mi := &file_steelbeam_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +532,7 @@ func (x *GetBeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBeamRequest.ProtoReflect.Descriptor instead.
 func (*GetBeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{3}
+	return file_steelbeam_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetBeamRequest) GetSectionDesignation() string {
@@ -409,6 +542,13 @@ func (x *GetBeamRequest) GetSectionDesignation() string {
 	return ""
 }
 
+func (x *GetBeamRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
 // Response message containing a single beam
 type GetBeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -420,7 +560,7 @@ type GetBeamResponse struct {
 
 func (x *GetBeamResponse) Reset() {
 	*x = GetBeamResponse{}
-	mi := &file_proto_steelbeam_proto_msgTypes[4]
+	mi := &file_steelbeam_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +572,7 @@ func (x *GetBeamResponse) String() string {
 func (*GetBeamResponse) ProtoMessage() {}
 
 func (x *GetBeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[4]
+	mi := &file_steelbeam_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +585,7 @@ func (x *GetBeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBeamResponse.ProtoReflect.Descriptor instead.
 func (*GetBeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{4}
+	return file_steelbeam_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetBeamResponse) GetBeam() *SteelBeam {
@@ -464,15 +604,17 @@ func (x *GetBeamResponse) GetFound() bool {
 
 // Request message to create a new beam
 type CreateBeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Beam          *SteelBeam             `protobuf:"bytes,1,opt,name=beam,proto3" json:"beam,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Beam  *SteelBeam             `protobuf:"bytes,1,opt,name=beam,proto3" json:"beam,omitempty"`
+	// Fill properties left at zero from the beam's geometry
+	DeriveMissing bool `protobuf:"varint,2,opt,name=derive_missing,json=deriveMissing,proto3" json:"derive_missing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateBeamRequest) Reset() {
 	*x = CreateBeamRequest{}
-	mi := &file_proto_steelbeam_proto_msgTypes[5]
+	mi := &file_steelbeam_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +626,7 @@ func (x *CreateBeamRequest) String() string {
 func (*CreateBeamRequest) ProtoMessage() {}
 
 func (x *CreateBeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[5]
+	mi := &file_steelbeam_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +639,7 @@ func (x *CreateBeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBeamRequest.ProtoReflect.Descriptor instead.
 func (*CreateBeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{5}
+	return file_steelbeam_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateBeamRequest) GetBeam() *SteelBeam {
@@ -507,6 +649,13 @@ func (x *CreateBeamRequest) GetBeam() *SteelBeam {
 	return nil
 }
 
+func (x *CreateBeamRequest) GetDeriveMissing() bool {
+	if x != nil {
+		return x.DeriveMissing
+	}
+	return false
+}
+
 // Response message for beam creation
 type CreateBeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -519,7 +668,7 @@ type CreateBeamResponse struct {
 
 func (x *CreateBeamResponse) Reset() {
 	*x = CreateBeamResponse{}
-	mi := &file_proto_steelbeam_proto_msgTypes[6]
+	mi := &file_steelbeam_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +680,7 @@ func (x *CreateBeamResponse) String() string {
 func (*CreateBeamResponse) ProtoMessage() {}
 
 func (x *CreateBeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[6]
+	mi := &file_steelbeam_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +693,7 @@ func (x *CreateBeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBeamResponse.ProtoReflect.Descriptor instead.
 func (*CreateBeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{6}
+	return file_steelbeam_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateBeamResponse) GetBeam() *SteelBeam {
@@ -568,30 +717,35 @@ func (x *CreateBeamResponse) GetMessage() string {
 	return ""
 }
 
-// Request message for stock status
-type GetStockStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Postcode      string                 `protobuf:"bytes,2,opt,name=postcode,proto3" json:"postcode,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request message for deriving section properties from I-section geometry
+// in mm. section_designation is optional and copied to the result.
+type DeriveSectionPropertiesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	DepthOfSection     float64                `protobuf:"fixed64,2,opt,name=depth_of_section,json=depthOfSection,proto3" json:"depth_of_section,omitempty"`
+	WidthOfSection     float64                `protobuf:"fixed64,3,opt,name=width_of_section,json=widthOfSection,proto3" json:"width_of_section,omitempty"`
+	ThicknessWeb       float64                `protobuf:"fixed64,4,opt,name=thickness_web,json=thicknessWeb,proto3" json:"thickness_web,omitempty"`
+	ThicknessFlange    float64                `protobuf:"fixed64,5,opt,name=thickness_flange,json=thicknessFlange,proto3" json:"thickness_flange,omitempty"`
+	RootRadius         float64                `protobuf:"fixed64,6,opt,name=root_radius,json=rootRadius,proto3" json:"root_radius,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetStockStatusRequest) Reset() {
-	*x = GetStockStatusRequest{}
-	mi := &file_proto_steelbeam_proto_msgTypes[7]
+func (x *DeriveSectionPropertiesRequest) Reset() {
+	*x = DeriveSectionPropertiesRequest{}
+	mi := &file_steelbeam_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStockStatusRequest) String() string {
+func (x *DeriveSectionPropertiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStockStatusRequest) ProtoMessage() {}
+func (*DeriveSectionPropertiesRequest) ProtoMessage() {}
 
-func (x *GetStockStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[7]
+func (x *DeriveSectionPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,52 +756,76 @@ func (x *GetStockStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStockStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetStockStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeriveSectionPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*DeriveSectionPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetStockStatusRequest) GetProductId() string {
+func (x *DeriveSectionPropertiesRequest) GetSectionDesignation() string {
 	if x != nil {
-		return x.ProductId
+		return x.SectionDesignation
 	}
 	return ""
 }
 
-func (x *GetStockStatusRequest) GetPostcode() string {
+func (x *DeriveSectionPropertiesRequest) GetDepthOfSection() float64 {
 	if x != nil {
-		return x.Postcode
+		return x.DepthOfSection
 	}
-	return ""
+	return 0
 }
 
-// Response message for stock status
-type GetStockStatusResponse struct {
+func (x *DeriveSectionPropertiesRequest) GetWidthOfSection() float64 {
+	if x != nil {
+		return x.WidthOfSection
+	}
+	return 0
+}
+
+func (x *DeriveSectionPropertiesRequest) GetThicknessWeb() float64 {
+	if x != nil {
+		return x.ThicknessWeb
+	}
+	return 0
+}
+
+func (x *DeriveSectionPropertiesRequest) GetThicknessFlange() float64 {
+	if x != nil {
+		return x.ThicknessFlange
+	}
+	return 0
+}
+
+func (x *DeriveSectionPropertiesRequest) GetRootRadius() float64 {
+	if x != nil {
+		return x.RootRadius
+	}
+	return 0
+}
+
+// Response message for derived section properties
+type DeriveSectionPropertiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Postcode      string                 `protobuf:"bytes,2,opt,name=postcode,proto3" json:"postcode,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Beam          *SteelBeam             `protobuf:"bytes,1,opt,name=beam,proto3" json:"beam,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStockStatusResponse) Reset() {
-	*x = GetStockStatusResponse{}
-	mi := &file_proto_steelbeam_proto_msgTypes[8]
+func (x *DeriveSectionPropertiesResponse) Reset() {
+	*x = DeriveSectionPropertiesResponse{}
+	mi := &file_steelbeam_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStockStatusResponse) String() string {
+func (x *DeriveSectionPropertiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStockStatusResponse) ProtoMessage() {}
+func (*DeriveSectionPropertiesResponse) ProtoMessage() {}
 
-func (x *GetStockStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_steelbeam_proto_msgTypes[8]
+func (x *DeriveSectionPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,179 +836,4236 @@ func (x *GetStockStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStockStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetStockStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_steelbeam_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeriveSectionPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*DeriveSectionPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetStockStatusResponse) GetProductId() string {
+func (x *DeriveSectionPropertiesResponse) GetBeam() *SteelBeam {
 	if x != nil {
-		return x.ProductId
+		return x.Beam
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStockStatusResponse) GetPostcode() string {
+// Options for an ImportBeams stream
+type ImportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "insert" (default) rejects beams already stored; "upsert" replaces them
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Check every beam and report what would happen without writing
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Fill properties left at zero from each beam's geometry
+	DeriveMissing bool `protobuf:"varint,3,opt,name=derive_missing,json=deriveMissing,proto3" json:"derive_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_steelbeam_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[10]
 	if x != nil {
-		return x.Postcode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetStockStatusResponse) GetStatus() string {
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportOptions) GetMode() string {
 	if x != nil {
-		return x.Status
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *GetStockStatusResponse) GetSuccess() bool {
+func (x *ImportOptions) GetDryRun() bool {
 	if x != nil {
-		return x.Success
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *GetStockStatusResponse) GetMessage() string {
+func (x *ImportOptions) GetDeriveMissing() bool {
 	if x != nil {
-		return x.Message
+		return x.DeriveMissing
 	}
-	return ""
+	return false
+}
+
+// One message of an ImportBeams stream: optionally the options, which must
+// come first, then one message per beam
+type ImportBeamsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*ImportBeamsRequest_Options
+	//	*ImportBeamsRequest_Beam
+	Item          isImportBeamsRequest_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var File_proto_steelbeam_proto protoreflect.FileDescriptor
+func (x *ImportBeamsRequest) Reset() {
+	*x = ImportBeamsRequest{}
+	mi := &file_steelbeam_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_proto_steelbeam_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/steelbeam.proto\x12\tsteelbeam\"\xe7\n" +
-	"\n" +
-	"\tSteelBeam\x12/\n" +
-	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12$\n" +
-	"\x0emass_per_metre\x18\x02 \x01(\x01R\fmassPerMetre\x12(\n" +
-	"\x10depth_of_section\x18\x03 \x01(\x01R\x0edepthOfSection\x12(\n" +
-	"\x10width_of_section\x18\x04 \x01(\x01R\x0ewidthOfSection\x12#\n" +
-	"\rthickness_web\x18\x05 \x01(\x01R\fthicknessWeb\x12)\n" +
-	"\x10thickness_flange\x18\x06 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
-	"\vroot_radius\x18\a \x01(\x01R\n" +
-	"rootRadius\x122\n" +
-	"\x15depth_between_fillets\x18\b \x01(\x01R\x13depthBetweenFillets\x12@\n" +
-	"\x1dratios_for_local_buckling_web\x18\t \x01(\x01R\x19ratiosForLocalBucklingWeb\x12F\n" +
-	" ratios_for_local_buckling_flange\x18\n" +
-	" \x01(\x01R\x1cratiosForLocalBucklingFlange\x12#\n" +
-	"\rend_clearance\x18\v \x01(\x01R\fendClearance\x12\x14\n" +
-	"\x05notch\x18\f \x01(\x01R\x05notch\x12;\n" +
-	"\x1adimensions_for_detailing_n\x18\r \x01(\x01R\x17dimensionsForDetailingN\x123\n" +
-	"\x16surface_area_per_metre\x18\x0e \x01(\x01R\x13surfaceAreaPerMetre\x123\n" +
-	"\x16surface_area_per_tonne\x18\x0f \x01(\x01R\x13surfaceAreaPerTonne\x12=\n" +
-	"\x1csecond_moment_of_area_axis_y\x18\x10 \x01(\x01R\x17secondMomentOfAreaAxisY\x12=\n" +
-	"\x1csecond_moment_of_area_axis_z\x18\x11 \x01(\x01R\x17secondMomentOfAreaAxisZ\x128\n" +
-	"\x19radius_of_gyration_axis_y\x18\x12 \x01(\x01R\x15radiusOfGyrationAxisY\x128\n" +
-	"\x19radius_of_gyration_axis_z\x18\x13 \x01(\x01R\x15radiusOfGyrationAxisZ\x123\n" +
-	"\x16elastic_modulus_axis_y\x18\x14 \x01(\x01R\x13elasticModulusAxisY\x123\n" +
-	"\x16elastic_modulus_axis_z\x18\x15 \x01(\x01R\x13elasticModulusAxisZ\x123\n" +
-	"\x16plastic_modulus_axis_y\x18\x16 \x01(\x01R\x13plasticModulusAxisY\x123\n" +
-	"\x16plastic_modulus_axis_z\x18\x17 \x01(\x01R\x13plasticModulusAxisZ\x12-\n" +
-	"\x12buckling_parameter\x18\x18 \x01(\x01R\x11bucklingParameter\x12'\n" +
-	"\x0ftorsional_index\x18\x19 \x01(\x01R\x0etorsionalIndex\x12)\n" +
-	"\x10warping_constant\x18\x1a \x01(\x01R\x0fwarpingConstant\x12-\n" +
-	"\x12torsional_constant\x18\x1b \x01(\x01R\x11torsionalConstant\x12&\n" +
-	"\x0farea_of_section\x18\x1c \x01(\x01R\rareaOfSection\"\x11\n" +
-	"\x0fGetBeamsRequest\">\n" +
-	"\x10GetBeamsResponse\x12*\n" +
-	"\x05beams\x18\x01 \x03(\v2\x14.steelbeam.SteelBeamR\x05beams\"A\n" +
-	"\x0eGetBeamRequest\x12/\n" +
-	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\"Q\n" +
-	"\x0fGetBeamResponse\x12(\n" +
-	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12\x14\n" +
-	"\x05found\x18\x02 \x01(\bR\x05found\"=\n" +
-	"\x11CreateBeamRequest\x12(\n" +
-	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\"r\n" +
-	"\x12CreateBeamResponse\x12(\n" +
-	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"R\n" +
-	"\x15GetStockStatusRequest\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bpostcode\x18\x02 \x01(\tR\bpostcode\"\x9f\x01\n" +
-	"\x16GetStockStatusResponse\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bpostcode\x18\x02 \x01(\tR\bpostcode\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
-	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage2\xbb\x02\n" +
-	"\x10SteelBeamService\x12C\n" +
-	"\bGetBeams\x12\x1a.steelbeam.GetBeamsRequest\x1a\x1b.steelbeam.GetBeamsResponse\x12@\n" +
-	"\aGetBeam\x12\x19.steelbeam.GetBeamRequest\x1a\x1a.steelbeam.GetBeamResponse\x12I\n" +
-	"\n" +
-	"CreateBeam\x12\x1c.steelbeam.CreateBeamRequest\x1a\x1d.steelbeam.CreateBeamResponse\x12U\n" +
-	"\x0eGetStockStatus\x12 .steelbeam.GetStockStatusRequest\x1a!.steelbeam.GetStockStatusResponseB%Z#formandfunction-api/proto/steelbeamb\x06proto3"
+func (x *ImportBeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var (
-	file_proto_steelbeam_proto_rawDescOnce sync.Once
-	file_proto_steelbeam_proto_rawDescData []byte
-)
+func (*ImportBeamsRequest) ProtoMessage() {}
 
-func file_proto_steelbeam_proto_rawDescGZIP() []byte {
-	file_proto_steelbeam_proto_rawDescOnce.Do(func() {
-		file_proto_steelbeam_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_steelbeam_proto_rawDesc), len(file_proto_steelbeam_proto_rawDesc)))
-	})
-	return file_proto_steelbeam_proto_rawDescData
-}
-
-var file_proto_steelbeam_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_proto_steelbeam_proto_goTypes = []any{
-	(*SteelBeam)(nil),              // 0: steelbeam.SteelBeam
-	(*GetBeamsRequest)(nil),        // 1: steelbeam.GetBeamsRequest
-	(*GetBeamsResponse)(nil),       // 2: steelbeam.GetBeamsResponse
-	(*GetBeamRequest)(nil),         // 3: steelbeam.GetBeamRequest
-	(*GetBeamResponse)(nil),        // 4: steelbeam.GetBeamResponse
-	(*CreateBeamRequest)(nil),      // 5: steelbeam.CreateBeamRequest
-	(*CreateBeamResponse)(nil),     // 6: steelbeam.CreateBeamResponse
-	(*GetStockStatusRequest)(nil),  // 7: steelbeam.GetStockStatusRequest
-	(*GetStockStatusResponse)(nil), // 8: steelbeam.GetStockStatusResponse
-}
-var file_proto_steelbeam_proto_depIdxs = []int32{
-	0, // 0: steelbeam.GetBeamsResponse.beams:type_name -> steelbeam.SteelBeam
-	0, // 1: steelbeam.GetBeamResponse.beam:type_name -> steelbeam.SteelBeam
-	0, // 2: steelbeam.CreateBeamRequest.beam:type_name -> steelbeam.SteelBeam
-	0, // 3: steelbeam.CreateBeamResponse.beam:type_name -> steelbeam.SteelBeam
-	1, // 4: steelbeam.SteelBeamService.GetBeams:input_type -> steelbeam.GetBeamsRequest
-	3, // 5: steelbeam.SteelBeamService.GetBeam:input_type -> steelbeam.GetBeamRequest
-	5, // 6: steelbeam.SteelBeamService.CreateBeam:input_type -> steelbeam.CreateBeamRequest
-	7, // 7: steelbeam.SteelBeamService.GetStockStatus:input_type -> steelbeam.GetStockStatusRequest
-	2, // 8: steelbeam.SteelBeamService.GetBeams:output_type -> steelbeam.GetBeamsResponse
-	4, // 9: steelbeam.SteelBeamService.GetBeam:output_type -> steelbeam.GetBeamResponse
-	6, // 10: steelbeam.SteelBeamService.CreateBeam:output_type -> steelbeam.CreateBeamResponse
-	8, // 11: steelbeam.SteelBeamService.GetStockStatus:output_type -> steelbeam.GetStockStatusResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_proto_steelbeam_proto_init() }
-func file_proto_steelbeam_proto_init() {
-	if File_proto_steelbeam_proto != nil {
-		return
+func (x *ImportBeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBeamsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBeamsRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportBeamsRequest) GetItem() isImportBeamsRequest_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ImportBeamsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Item.(*ImportBeamsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportBeamsRequest) GetBeam() *SteelBeam {
+	if x != nil {
+		if x, ok := x.Item.(*ImportBeamsRequest_Beam); ok {
+			return x.Beam
+		}
+	}
+	return nil
+}
+
+type isImportBeamsRequest_Item interface {
+	isImportBeamsRequest_Item()
+}
+
+type ImportBeamsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportBeamsRequest_Beam struct {
+	Beam *SteelBeam `protobuf:"bytes,2,opt,name=beam,proto3,oneof"`
+}
+
+func (*ImportBeamsRequest_Options) isImportBeamsRequest_Item() {}
+
+func (*ImportBeamsRequest_Beam) isImportBeamsRequest_Item() {}
+
+// A problem with one field, named by its SteelBeam field name
+type FieldError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	mi := &file_steelbeam_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Result of one imported beam. row is its 1-based position in the stream;
+// action is "created", "updated" or "failed".
+type ImportRowResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Row                int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	SectionDesignation string                 `protobuf:"bytes,2,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	Action             string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Error              string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Errors             []*FieldError          `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_steelbeam_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Response message for an import, reporting every beam
+type ImportBeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,7,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBeamsResponse) Reset() {
+	*x = ImportBeamsResponse{}
+	mi := &file_steelbeam_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBeamsResponse) ProtoMessage() {}
+
+func (x *ImportBeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBeamsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBeamsResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportBeamsResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportBeamsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportBeamsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportBeamsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportBeamsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportBeamsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportBeamsResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Request message for beam update. section_designation identifies the
+// beam to replace; beam is its replacement.
+type UpdateBeamRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	Beam               *SteelBeam             `protobuf:"bytes,2,opt,name=beam,proto3" json:"beam,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateBeamRequest) Reset() {
+	*x = UpdateBeamRequest{}
+	mi := &file_steelbeam_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBeamRequest) ProtoMessage() {}
+
+func (x *UpdateBeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBeamRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateBeamRequest) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *UpdateBeamRequest) GetBeam() *SteelBeam {
+	if x != nil {
+		return x.Beam
+	}
+	return nil
+}
+
+// Response message for beam update
+type UpdateBeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Beam          *SteelBeam             `protobuf:"bytes,1,opt,name=beam,proto3" json:"beam,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBeamResponse) Reset() {
+	*x = UpdateBeamResponse{}
+	mi := &file_steelbeam_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBeamResponse) ProtoMessage() {}
+
+func (x *UpdateBeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBeamResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBeamResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateBeamResponse) GetBeam() *SteelBeam {
+	if x != nil {
+		return x.Beam
+	}
+	return nil
+}
+
+func (x *UpdateBeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBeamResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request message for beam deletion
+type DeleteBeamRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteBeamRequest) Reset() {
+	*x = DeleteBeamRequest{}
+	mi := &file_steelbeam_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBeamRequest) ProtoMessage() {}
+
+func (x *DeleteBeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBeamRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteBeamRequest) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+// Response message for beam deletion
+type DeleteBeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBeamResponse) Reset() {
+	*x = DeleteBeamResponse{}
+	mi := &file_steelbeam_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBeamResponse) ProtoMessage() {}
+
+func (x *DeleteBeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBeamResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteBeamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteBeamResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request message to watch catalogue changes. When resume_token is set,
+// events after it are replayed before new ones are streamed.
+type WatchBeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBeamsRequest) Reset() {
+	*x = WatchBeamsRequest{}
+	mi := &file_steelbeam_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBeamsRequest) ProtoMessage() {}
+
+func (x *WatchBeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBeamsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBeamsRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchBeamsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// A change to the catalogue. type is "created", "updated" or "deleted";
+// beam is unset for deletions.
+type BeamEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SectionDesignation string                 `protobuf:"bytes,2,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	Beam               *SteelBeam             `protobuf:"bytes,4,opt,name=beam,proto3" json:"beam,omitempty"`
+	ResumeToken        string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BeamEvent) Reset() {
+	*x = BeamEvent{}
+	mi := &file_steelbeam_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeamEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeamEvent) ProtoMessage() {}
+
+func (x *BeamEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeamEvent.ProtoReflect.Descriptor instead.
+func (*BeamEvent) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BeamEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BeamEvent) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *BeamEvent) GetBeam() *SteelBeam {
+	if x != nil {
+		return x.Beam
+	}
+	return nil
+}
+
+func (x *BeamEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *BeamEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Request message for stock status
+type GetStockStatusRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Postcode  string                 `protobuf:"bytes,2,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	// Merchant to ask; empty for the default, "all" to ask every merchant
+	Supplier string `protobuf:"bytes,3,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// Optional: a single branch to ask about
+	BranchId string `protobuf:"bytes,4,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	// Optional: the nearest branches to return; 0 for the merchant default
+	BranchLimit int32 `protobuf:"varint,5,opt,name=branch_limit,json=branchLimit,proto3" json:"branch_limit,omitempty"`
+	// Optional: only branches within this many miles of the postcode
+	WithinRadius  float64 `protobuf:"fixed64,6,opt,name=within_radius,json=withinRadius,proto3" json:"within_radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockStatusRequest) Reset() {
+	*x = GetStockStatusRequest{}
+	mi := &file_steelbeam_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockStatusRequest) ProtoMessage() {}
+
+func (x *GetStockStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStockStatusRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStockStatusRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetStockStatusRequest) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *GetStockStatusRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *GetStockStatusRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *GetStockStatusRequest) GetBranchLimit() int32 {
+	if x != nil {
+		return x.BranchLimit
+	}
+	return 0
+}
+
+func (x *GetStockStatusRequest) GetWithinRadius() float64 {
+	if x != nil {
+		return x.WithinRadius
+	}
+	return 0
+}
+
+// Stock of a product held at one merchant branch, in the merchant's unit
+type BranchStock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BranchId      string                 `protobuf:"bytes,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	StockLevel    float64                `protobuf:"fixed64,2,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	StockUom      string                 `protobuf:"bytes,3,opt,name=stock_uom,json=stockUom,proto3" json:"stock_uom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BranchStock) Reset() {
+	*x = BranchStock{}
+	mi := &file_steelbeam_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BranchStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchStock) ProtoMessage() {}
+
+func (x *BranchStock) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchStock.ProtoReflect.Descriptor instead.
+func (*BranchStock) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BranchStock) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *BranchStock) GetStockLevel() float64 {
+	if x != nil {
+		return x.StockLevel
+	}
+	return 0
+}
+
+func (x *BranchStock) GetStockUom() string {
+	if x != nil {
+		return x.StockUom
+	}
+	return ""
+}
+
+// One merchant's stock status, derived from its branches; error is set
+// instead of status if the merchant could not be reached
+type SupplierStock struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Supplier string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Status   string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Branches []*BranchStock         `protobuf:"bytes,4,rep,name=branches,proto3" json:"branches,omitempty"`
+	// "hit", "miss" or "coalesced": how the stock cache answered
+	Cache         string `protobuf:"bytes,5,opt,name=cache,proto3" json:"cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierStock) Reset() {
+	*x = SupplierStock{}
+	mi := &file_steelbeam_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierStock) ProtoMessage() {}
+
+func (x *SupplierStock) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierStock.ProtoReflect.Descriptor instead.
+func (*SupplierStock) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SupplierStock) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *SupplierStock) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SupplierStock) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SupplierStock) GetBranches() []*BranchStock {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *SupplierStock) GetCache() string {
+	if x != nil {
+		return x.Cache
+	}
+	return ""
+}
+
+// Response message for stock status. status summarises branches. With
+// supplier "all", status is the best status across suppliers and suppliers
+// lists each merchant's answer with its own branches.
+type GetStockStatusResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Postcode  string                 `protobuf:"bytes,2,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Success   bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Supplier  string                 `protobuf:"bytes,6,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Suppliers []*SupplierStock       `protobuf:"bytes,7,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	Branches  []*BranchStock         `protobuf:"bytes,8,rep,name=branches,proto3" json:"branches,omitempty"`
+	// "hit", "miss" or "coalesced": how the stock cache answered
+	Cache         string `protobuf:"bytes,9,opt,name=cache,proto3" json:"cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockStatusResponse) Reset() {
+	*x = GetStockStatusResponse{}
+	mi := &file_steelbeam_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockStatusResponse) ProtoMessage() {}
+
+func (x *GetStockStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStockStatusResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetStockStatusResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetStockStatusResponse) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *GetStockStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetStockStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetStockStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStockStatusResponse) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *GetStockStatusResponse) GetSuppliers() []*SupplierStock {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *GetStockStatusResponse) GetBranches() []*BranchStock {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+func (x *GetStockStatusResponse) GetCache() string {
+	if x != nil {
+		return x.Cache
+	}
+	return ""
+}
+
+// Section message representing a steel section of any family. Exactly one
+// of the family-specific property blocks is set.
+type Section struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation      string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	Family                  string                 `protobuf:"bytes,2,opt,name=family,proto3" json:"family,omitempty"`
+	MassPerMetre            float64                `protobuf:"fixed64,3,opt,name=mass_per_metre,json=massPerMetre,proto3" json:"mass_per_metre,omitempty"`
+	AreaOfSection           float64                `protobuf:"fixed64,4,opt,name=area_of_section,json=areaOfSection,proto3" json:"area_of_section,omitempty"`
+	SurfaceAreaPerMetre     float64                `protobuf:"fixed64,5,opt,name=surface_area_per_metre,json=surfaceAreaPerMetre,proto3" json:"surface_area_per_metre,omitempty"`
+	SecondMomentOfAreaAxisY float64                `protobuf:"fixed64,6,opt,name=second_moment_of_area_axis_y,json=secondMomentOfAreaAxisY,proto3" json:"second_moment_of_area_axis_y,omitempty"`
+	SecondMomentOfAreaAxisZ float64                `protobuf:"fixed64,7,opt,name=second_moment_of_area_axis_z,json=secondMomentOfAreaAxisZ,proto3" json:"second_moment_of_area_axis_z,omitempty"`
+	RadiusOfGyrationAxisY   float64                `protobuf:"fixed64,8,opt,name=radius_of_gyration_axis_y,json=radiusOfGyrationAxisY,proto3" json:"radius_of_gyration_axis_y,omitempty"`
+	RadiusOfGyrationAxisZ   float64                `protobuf:"fixed64,9,opt,name=radius_of_gyration_axis_z,json=radiusOfGyrationAxisZ,proto3" json:"radius_of_gyration_axis_z,omitempty"`
+	ElasticModulusAxisY     float64                `protobuf:"fixed64,10,opt,name=elastic_modulus_axis_y,json=elasticModulusAxisY,proto3" json:"elastic_modulus_axis_y,omitempty"`
+	ElasticModulusAxisZ     float64                `protobuf:"fixed64,11,opt,name=elastic_modulus_axis_z,json=elasticModulusAxisZ,proto3" json:"elastic_modulus_axis_z,omitempty"`
+	PlasticModulusAxisY     float64                `protobuf:"fixed64,12,opt,name=plastic_modulus_axis_y,json=plasticModulusAxisY,proto3" json:"plastic_modulus_axis_y,omitempty"`
+	PlasticModulusAxisZ     float64                `protobuf:"fixed64,13,opt,name=plastic_modulus_axis_z,json=plasticModulusAxisZ,proto3" json:"plastic_modulus_axis_z,omitempty"`
+	TorsionalConstant       float64                `protobuf:"fixed64,14,opt,name=torsional_constant,json=torsionalConstant,proto3" json:"torsional_constant,omitempty"`
+	// Types that are valid to be assigned to Properties:
+	//
+	//	*Section_ISection
+	//	*Section_Channel
+	//	*Section_Angle
+	//	*Section_Hollow
+	//	*Section_Tee
+	Properties    isSection_Properties `protobuf_oneof:"properties"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	mi := &file_steelbeam_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Section) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *Section) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *Section) GetMassPerMetre() float64 {
+	if x != nil {
+		return x.MassPerMetre
+	}
+	return 0
+}
+
+func (x *Section) GetAreaOfSection() float64 {
+	if x != nil {
+		return x.AreaOfSection
+	}
+	return 0
+}
+
+func (x *Section) GetSurfaceAreaPerMetre() float64 {
+	if x != nil {
+		return x.SurfaceAreaPerMetre
+	}
+	return 0
+}
+
+func (x *Section) GetSecondMomentOfAreaAxisY() float64 {
+	if x != nil {
+		return x.SecondMomentOfAreaAxisY
+	}
+	return 0
+}
+
+func (x *Section) GetSecondMomentOfAreaAxisZ() float64 {
+	if x != nil {
+		return x.SecondMomentOfAreaAxisZ
+	}
+	return 0
+}
+
+func (x *Section) GetRadiusOfGyrationAxisY() float64 {
+	if x != nil {
+		return x.RadiusOfGyrationAxisY
+	}
+	return 0
+}
+
+func (x *Section) GetRadiusOfGyrationAxisZ() float64 {
+	if x != nil {
+		return x.RadiusOfGyrationAxisZ
+	}
+	return 0
+}
+
+func (x *Section) GetElasticModulusAxisY() float64 {
+	if x != nil {
+		return x.ElasticModulusAxisY
+	}
+	return 0
+}
+
+func (x *Section) GetElasticModulusAxisZ() float64 {
+	if x != nil {
+		return x.ElasticModulusAxisZ
+	}
+	return 0
+}
+
+func (x *Section) GetPlasticModulusAxisY() float64 {
+	if x != nil {
+		return x.PlasticModulusAxisY
+	}
+	return 0
+}
+
+func (x *Section) GetPlasticModulusAxisZ() float64 {
+	if x != nil {
+		return x.PlasticModulusAxisZ
+	}
+	return 0
+}
+
+func (x *Section) GetTorsionalConstant() float64 {
+	if x != nil {
+		return x.TorsionalConstant
+	}
+	return 0
+}
+
+func (x *Section) GetProperties() isSection_Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Section) GetISection() *ISectionProperties {
+	if x != nil {
+		if x, ok := x.Properties.(*Section_ISection); ok {
+			return x.ISection
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetChannel() *ChannelProperties {
+	if x != nil {
+		if x, ok := x.Properties.(*Section_Channel); ok {
+			return x.Channel
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetAngle() *AngleProperties {
+	if x != nil {
+		if x, ok := x.Properties.(*Section_Angle); ok {
+			return x.Angle
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetHollow() *HollowSectionProperties {
+	if x != nil {
+		if x, ok := x.Properties.(*Section_Hollow); ok {
+			return x.Hollow
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetTee() *TeeProperties {
+	if x != nil {
+		if x, ok := x.Properties.(*Section_Tee); ok {
+			return x.Tee
+		}
+	}
+	return nil
+}
+
+type isSection_Properties interface {
+	isSection_Properties()
+}
+
+type Section_ISection struct {
+	ISection *ISectionProperties `protobuf:"bytes,20,opt,name=i_section,json=iSection,proto3,oneof"`
+}
+
+type Section_Channel struct {
+	Channel *ChannelProperties `protobuf:"bytes,21,opt,name=channel,proto3,oneof"`
+}
+
+type Section_Angle struct {
+	Angle *AngleProperties `protobuf:"bytes,22,opt,name=angle,proto3,oneof"`
+}
+
+type Section_Hollow struct {
+	Hollow *HollowSectionProperties `protobuf:"bytes,23,opt,name=hollow,proto3,oneof"`
+}
+
+type Section_Tee struct {
+	Tee *TeeProperties `protobuf:"bytes,24,opt,name=tee,proto3,oneof"`
+}
+
+func (*Section_ISection) isSection_Properties() {}
+
+func (*Section_Channel) isSection_Properties() {}
+
+func (*Section_Angle) isSection_Properties() {}
+
+func (*Section_Hollow) isSection_Properties() {}
+
+func (*Section_Tee) isSection_Properties() {}
+
+// Dimensions and torsional properties of UB and UC sections
+type ISectionProperties struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DepthOfSection      float64                `protobuf:"fixed64,1,opt,name=depth_of_section,json=depthOfSection,proto3" json:"depth_of_section,omitempty"`
+	WidthOfSection      float64                `protobuf:"fixed64,2,opt,name=width_of_section,json=widthOfSection,proto3" json:"width_of_section,omitempty"`
+	ThicknessWeb        float64                `protobuf:"fixed64,3,opt,name=thickness_web,json=thicknessWeb,proto3" json:"thickness_web,omitempty"`
+	ThicknessFlange     float64                `protobuf:"fixed64,4,opt,name=thickness_flange,json=thicknessFlange,proto3" json:"thickness_flange,omitempty"`
+	RootRadius          float64                `protobuf:"fixed64,5,opt,name=root_radius,json=rootRadius,proto3" json:"root_radius,omitempty"`
+	DepthBetweenFillets float64                `protobuf:"fixed64,6,opt,name=depth_between_fillets,json=depthBetweenFillets,proto3" json:"depth_between_fillets,omitempty"`
+	BucklingParameter   float64                `protobuf:"fixed64,7,opt,name=buckling_parameter,json=bucklingParameter,proto3" json:"buckling_parameter,omitempty"`
+	TorsionalIndex      float64                `protobuf:"fixed64,8,opt,name=torsional_index,json=torsionalIndex,proto3" json:"torsional_index,omitempty"`
+	WarpingConstant     float64                `protobuf:"fixed64,9,opt,name=warping_constant,json=warpingConstant,proto3" json:"warping_constant,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ISectionProperties) Reset() {
+	*x = ISectionProperties{}
+	mi := &file_steelbeam_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISectionProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISectionProperties) ProtoMessage() {}
+
+func (x *ISectionProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISectionProperties.ProtoReflect.Descriptor instead.
+func (*ISectionProperties) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ISectionProperties) GetDepthOfSection() float64 {
+	if x != nil {
+		return x.DepthOfSection
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetWidthOfSection() float64 {
+	if x != nil {
+		return x.WidthOfSection
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetThicknessWeb() float64 {
+	if x != nil {
+		return x.ThicknessWeb
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetThicknessFlange() float64 {
+	if x != nil {
+		return x.ThicknessFlange
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetRootRadius() float64 {
+	if x != nil {
+		return x.RootRadius
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetDepthBetweenFillets() float64 {
+	if x != nil {
+		return x.DepthBetweenFillets
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetBucklingParameter() float64 {
+	if x != nil {
+		return x.BucklingParameter
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetTorsionalIndex() float64 {
+	if x != nil {
+		return x.TorsionalIndex
+	}
+	return 0
+}
+
+func (x *ISectionProperties) GetWarpingConstant() float64 {
+	if x != nil {
+		return x.WarpingConstant
+	}
+	return 0
+}
+
+// Dimensions of parallel flange channels
+type ChannelProperties struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	DepthOfSection          float64                `protobuf:"fixed64,1,opt,name=depth_of_section,json=depthOfSection,proto3" json:"depth_of_section,omitempty"`
+	WidthOfSection          float64                `protobuf:"fixed64,2,opt,name=width_of_section,json=widthOfSection,proto3" json:"width_of_section,omitempty"`
+	ThicknessWeb            float64                `protobuf:"fixed64,3,opt,name=thickness_web,json=thicknessWeb,proto3" json:"thickness_web,omitempty"`
+	ThicknessFlange         float64                `protobuf:"fixed64,4,opt,name=thickness_flange,json=thicknessFlange,proto3" json:"thickness_flange,omitempty"`
+	RootRadius              float64                `protobuf:"fixed64,5,opt,name=root_radius,json=rootRadius,proto3" json:"root_radius,omitempty"`
+	DepthBetweenFillets     float64                `protobuf:"fixed64,6,opt,name=depth_between_fillets,json=depthBetweenFillets,proto3" json:"depth_between_fillets,omitempty"`
+	CentroidDistance        float64                `protobuf:"fixed64,7,opt,name=centroid_distance,json=centroidDistance,proto3" json:"centroid_distance,omitempty"`
+	ShearCentreFromCentroid float64                `protobuf:"fixed64,8,opt,name=shear_centre_from_centroid,json=shearCentreFromCentroid,proto3" json:"shear_centre_from_centroid,omitempty"`
+	WarpingConstant         float64                `protobuf:"fixed64,9,opt,name=warping_constant,json=warpingConstant,proto3" json:"warping_constant,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChannelProperties) Reset() {
+	*x = ChannelProperties{}
+	mi := &file_steelbeam_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelProperties) ProtoMessage() {}
+
+func (x *ChannelProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelProperties.ProtoReflect.Descriptor instead.
+func (*ChannelProperties) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChannelProperties) GetDepthOfSection() float64 {
+	if x != nil {
+		return x.DepthOfSection
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetWidthOfSection() float64 {
+	if x != nil {
+		return x.WidthOfSection
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetThicknessWeb() float64 {
+	if x != nil {
+		return x.ThicknessWeb
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetThicknessFlange() float64 {
+	if x != nil {
+		return x.ThicknessFlange
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetRootRadius() float64 {
+	if x != nil {
+		return x.RootRadius
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetDepthBetweenFillets() float64 {
+	if x != nil {
+		return x.DepthBetweenFillets
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetCentroidDistance() float64 {
+	if x != nil {
+		return x.CentroidDistance
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetShearCentreFromCentroid() float64 {
+	if x != nil {
+		return x.ShearCentreFromCentroid
+	}
+	return 0
+}
+
+func (x *ChannelProperties) GetWarpingConstant() float64 {
+	if x != nil {
+		return x.WarpingConstant
+	}
+	return 0
+}
+
+// Leg dimensions and principal axis properties of angles
+type AngleProperties struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	LegLengthLong           float64                `protobuf:"fixed64,1,opt,name=leg_length_long,json=legLengthLong,proto3" json:"leg_length_long,omitempty"`
+	LegLengthShort          float64                `protobuf:"fixed64,2,opt,name=leg_length_short,json=legLengthShort,proto3" json:"leg_length_short,omitempty"`
+	Thickness               float64                `protobuf:"fixed64,3,opt,name=thickness,proto3" json:"thickness,omitempty"`
+	RootRadius              float64                `protobuf:"fixed64,4,opt,name=root_radius,json=rootRadius,proto3" json:"root_radius,omitempty"`
+	ToeRadius               float64                `protobuf:"fixed64,5,opt,name=toe_radius,json=toeRadius,proto3" json:"toe_radius,omitempty"`
+	CentroidDistanceY       float64                `protobuf:"fixed64,6,opt,name=centroid_distance_y,json=centroidDistanceY,proto3" json:"centroid_distance_y,omitempty"`
+	CentroidDistanceZ       float64                `protobuf:"fixed64,7,opt,name=centroid_distance_z,json=centroidDistanceZ,proto3" json:"centroid_distance_z,omitempty"`
+	SecondMomentOfAreaAxisU float64                `protobuf:"fixed64,8,opt,name=second_moment_of_area_axis_u,json=secondMomentOfAreaAxisU,proto3" json:"second_moment_of_area_axis_u,omitempty"`
+	SecondMomentOfAreaAxisV float64                `protobuf:"fixed64,9,opt,name=second_moment_of_area_axis_v,json=secondMomentOfAreaAxisV,proto3" json:"second_moment_of_area_axis_v,omitempty"`
+	RadiusOfGyrationAxisU   float64                `protobuf:"fixed64,10,opt,name=radius_of_gyration_axis_u,json=radiusOfGyrationAxisU,proto3" json:"radius_of_gyration_axis_u,omitempty"`
+	RadiusOfGyrationAxisV   float64                `protobuf:"fixed64,11,opt,name=radius_of_gyration_axis_v,json=radiusOfGyrationAxisV,proto3" json:"radius_of_gyration_axis_v,omitempty"`
+	TanAlpha                float64                `protobuf:"fixed64,12,opt,name=tan_alpha,json=tanAlpha,proto3" json:"tan_alpha,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AngleProperties) Reset() {
+	*x = AngleProperties{}
+	mi := &file_steelbeam_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AngleProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AngleProperties) ProtoMessage() {}
+
+func (x *AngleProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AngleProperties.ProtoReflect.Descriptor instead.
+func (*AngleProperties) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AngleProperties) GetLegLengthLong() float64 {
+	if x != nil {
+		return x.LegLengthLong
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetLegLengthShort() float64 {
+	if x != nil {
+		return x.LegLengthShort
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetThickness() float64 {
+	if x != nil {
+		return x.Thickness
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetRootRadius() float64 {
+	if x != nil {
+		return x.RootRadius
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetToeRadius() float64 {
+	if x != nil {
+		return x.ToeRadius
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetCentroidDistanceY() float64 {
+	if x != nil {
+		return x.CentroidDistanceY
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetCentroidDistanceZ() float64 {
+	if x != nil {
+		return x.CentroidDistanceZ
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetSecondMomentOfAreaAxisU() float64 {
+	if x != nil {
+		return x.SecondMomentOfAreaAxisU
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetSecondMomentOfAreaAxisV() float64 {
+	if x != nil {
+		return x.SecondMomentOfAreaAxisV
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetRadiusOfGyrationAxisU() float64 {
+	if x != nil {
+		return x.RadiusOfGyrationAxisU
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetRadiusOfGyrationAxisV() float64 {
+	if x != nil {
+		return x.RadiusOfGyrationAxisV
+	}
+	return 0
+}
+
+func (x *AngleProperties) GetTanAlpha() float64 {
+	if x != nil {
+		return x.TanAlpha
+	}
+	return 0
+}
+
+// Dimensions of circular, rectangular and square hollow sections
+type HollowSectionProperties struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OutsideDiameter  float64                `protobuf:"fixed64,1,opt,name=outside_diameter,json=outsideDiameter,proto3" json:"outside_diameter,omitempty"`
+	DepthOfSection   float64                `protobuf:"fixed64,2,opt,name=depth_of_section,json=depthOfSection,proto3" json:"depth_of_section,omitempty"`
+	WidthOfSection   float64                `protobuf:"fixed64,3,opt,name=width_of_section,json=widthOfSection,proto3" json:"width_of_section,omitempty"`
+	WallThickness    float64                `protobuf:"fixed64,4,opt,name=wall_thickness,json=wallThickness,proto3" json:"wall_thickness,omitempty"`
+	TorsionalModulus float64                `protobuf:"fixed64,5,opt,name=torsional_modulus,json=torsionalModulus,proto3" json:"torsional_modulus,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HollowSectionProperties) Reset() {
+	*x = HollowSectionProperties{}
+	mi := &file_steelbeam_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HollowSectionProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HollowSectionProperties) ProtoMessage() {}
+
+func (x *HollowSectionProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HollowSectionProperties.ProtoReflect.Descriptor instead.
+func (*HollowSectionProperties) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HollowSectionProperties) GetOutsideDiameter() float64 {
+	if x != nil {
+		return x.OutsideDiameter
+	}
+	return 0
+}
+
+func (x *HollowSectionProperties) GetDepthOfSection() float64 {
+	if x != nil {
+		return x.DepthOfSection
+	}
+	return 0
+}
+
+func (x *HollowSectionProperties) GetWidthOfSection() float64 {
+	if x != nil {
+		return x.WidthOfSection
+	}
+	return 0
+}
+
+func (x *HollowSectionProperties) GetWallThickness() float64 {
+	if x != nil {
+		return x.WallThickness
+	}
+	return 0
+}
+
+func (x *HollowSectionProperties) GetTorsionalModulus() float64 {
+	if x != nil {
+		return x.TorsionalModulus
+	}
+	return 0
+}
+
+// Dimensions of tees
+type TeeProperties struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DepthOfSection   float64                `protobuf:"fixed64,1,opt,name=depth_of_section,json=depthOfSection,proto3" json:"depth_of_section,omitempty"`
+	WidthOfSection   float64                `protobuf:"fixed64,2,opt,name=width_of_section,json=widthOfSection,proto3" json:"width_of_section,omitempty"`
+	ThicknessWeb     float64                `protobuf:"fixed64,3,opt,name=thickness_web,json=thicknessWeb,proto3" json:"thickness_web,omitempty"`
+	ThicknessFlange  float64                `protobuf:"fixed64,4,opt,name=thickness_flange,json=thicknessFlange,proto3" json:"thickness_flange,omitempty"`
+	RootRadius       float64                `protobuf:"fixed64,5,opt,name=root_radius,json=rootRadius,proto3" json:"root_radius,omitempty"`
+	CentroidDistance float64                `protobuf:"fixed64,6,opt,name=centroid_distance,json=centroidDistance,proto3" json:"centroid_distance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TeeProperties) Reset() {
+	*x = TeeProperties{}
+	mi := &file_steelbeam_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeeProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeeProperties) ProtoMessage() {}
+
+func (x *TeeProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeeProperties.ProtoReflect.Descriptor instead.
+func (*TeeProperties) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TeeProperties) GetDepthOfSection() float64 {
+	if x != nil {
+		return x.DepthOfSection
+	}
+	return 0
+}
+
+func (x *TeeProperties) GetWidthOfSection() float64 {
+	if x != nil {
+		return x.WidthOfSection
+	}
+	return 0
+}
+
+func (x *TeeProperties) GetThicknessWeb() float64 {
+	if x != nil {
+		return x.ThicknessWeb
+	}
+	return 0
+}
+
+func (x *TeeProperties) GetThicknessFlange() float64 {
+	if x != nil {
+		return x.ThicknessFlange
+	}
+	return 0
+}
+
+func (x *TeeProperties) GetRootRadius() float64 {
+	if x != nil {
+		return x.RootRadius
+	}
+	return 0
+}
+
+func (x *TeeProperties) GetCentroidDistance() float64 {
+	if x != nil {
+		return x.CentroidDistance
+	}
+	return 0
+}
+
+// Request message to list sections, optionally restricted to one family
+type GetSectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Family        string                 `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSectionsRequest) Reset() {
+	*x = GetSectionsRequest{}
+	mi := &file_steelbeam_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSectionsRequest) ProtoMessage() {}
+
+func (x *GetSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSectionsRequest) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+// Response message containing list of sections
+type GetSectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*Section             `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSectionsResponse) Reset() {
+	*x = GetSectionsResponse{}
+	mi := &file_steelbeam_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSectionsResponse) ProtoMessage() {}
+
+func (x *GetSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSectionsResponse) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// Request message to get a specific section by designation
+type GetSectionRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSectionRequest) Reset() {
+	*x = GetSectionRequest{}
+	mi := &file_steelbeam_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSectionRequest) ProtoMessage() {}
+
+func (x *GetSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSectionRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSectionRequest) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+// Response message containing a single section
+type GetSectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       *Section               `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSectionResponse) Reset() {
+	*x = GetSectionResponse{}
+	mi := &file_steelbeam_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSectionResponse) ProtoMessage() {}
+
+func (x *GetSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSectionResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSectionResponse) GetSection() *Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *GetSectionResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+// Request message to select the lightest beams meeting the given bounds.
+// Keys are SteelBeam JSON tags, e.g. "plastic_modulus_axis_y".
+type SelectBeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Minimums map[string]float64     `protobuf:"bytes,1,rep,name=minimums,proto3" json:"minimums,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Maximums map[string]float64     `protobuf:"bytes,2,rep,name=maximums,proto3" json:"maximums,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Maximum number of candidates to return; zero returns every candidate
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectBeamRequest) Reset() {
+	*x = SelectBeamRequest{}
+	mi := &file_steelbeam_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectBeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectBeamRequest) ProtoMessage() {}
+
+func (x *SelectBeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectBeamRequest.ProtoReflect.Descriptor instead.
+func (*SelectBeamRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SelectBeamRequest) GetMinimums() map[string]float64 {
+	if x != nil {
+		return x.Minimums
+	}
+	return nil
+}
+
+func (x *SelectBeamRequest) GetMaximums() map[string]float64 {
+	if x != nil {
+		return x.Maximums
+	}
+	return nil
+}
+
+func (x *SelectBeamRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// The constraint a candidate satisfies with the least margin
+type GoverningConstraint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Field string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// "min" or "max"
+	Bound         string  `protobuf:"bytes,2,opt,name=bound,proto3" json:"bound,omitempty"`
+	Limit         float64 `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Value         float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Utilisation   float64 `protobuf:"fixed64,5,opt,name=utilisation,proto3" json:"utilisation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoverningConstraint) Reset() {
+	*x = GoverningConstraint{}
+	mi := &file_steelbeam_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoverningConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoverningConstraint) ProtoMessage() {}
+
+func (x *GoverningConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoverningConstraint.ProtoReflect.Descriptor instead.
+func (*GoverningConstraint) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GoverningConstraint) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *GoverningConstraint) GetBound() string {
+	if x != nil {
+		return x.Bound
+	}
+	return ""
+}
+
+func (x *GoverningConstraint) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GoverningConstraint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GoverningConstraint) GetUtilisation() float64 {
+	if x != nil {
+		return x.Utilisation
+	}
+	return 0
+}
+
+// A beam satisfying a selection together with its governing constraint
+type BeamCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Beam          *SteelBeam             `protobuf:"bytes,1,opt,name=beam,proto3" json:"beam,omitempty"`
+	Governing     *GoverningConstraint   `protobuf:"bytes,2,opt,name=governing,proto3" json:"governing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeamCandidate) Reset() {
+	*x = BeamCandidate{}
+	mi := &file_steelbeam_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeamCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeamCandidate) ProtoMessage() {}
+
+func (x *BeamCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeamCandidate.ProtoReflect.Descriptor instead.
+func (*BeamCandidate) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BeamCandidate) GetBeam() *SteelBeam {
+	if x != nil {
+		return x.Beam
+	}
+	return nil
+}
+
+func (x *BeamCandidate) GetGoverning() *GoverningConstraint {
+	if x != nil {
+		return x.Governing
+	}
+	return nil
+}
+
+// Response message containing candidates ranked by mass per metre
+type SelectBeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*BeamCandidate       `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectBeamResponse) Reset() {
+	*x = SelectBeamResponse{}
+	mi := &file_steelbeam_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectBeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectBeamResponse) ProtoMessage() {}
+
+func (x *SelectBeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectBeamResponse.ProtoReflect.Descriptor instead.
+func (*SelectBeamResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SelectBeamResponse) GetCandidates() []*BeamCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Request message for EN 1993-1-1 cross-section resistance of a beam
+type BeamResistanceRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	// Steel grade name, e.g. "S355"
+	SteelGrade    string `protobuf:"bytes,2,opt,name=steel_grade,json=steelGrade,proto3" json:"steel_grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeamResistanceRequest) Reset() {
+	*x = BeamResistanceRequest{}
+	mi := &file_steelbeam_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeamResistanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeamResistanceRequest) ProtoMessage() {}
+
+func (x *BeamResistanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeamResistanceRequest.ProtoReflect.Descriptor instead.
+func (*BeamResistanceRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BeamResistanceRequest) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *BeamResistanceRequest) GetSteelGrade() string {
+	if x != nil {
+		return x.SteelGrade
+	}
+	return ""
+}
+
+// Response message with classification and resistances about the major axis.
+// Moments are in kNm, forces in kN, strengths in N/mm² and areas in mm².
+type BeamResistanceResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation         string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	SteelGrade                 string                 `protobuf:"bytes,2,opt,name=steel_grade,json=steelGrade,proto3" json:"steel_grade,omitempty"`
+	YieldStrength              float64                `protobuf:"fixed64,3,opt,name=yield_strength,json=yieldStrength,proto3" json:"yield_strength,omitempty"`
+	Epsilon                    float64                `protobuf:"fixed64,4,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	WebClass                   int32                  `protobuf:"varint,5,opt,name=web_class,json=webClass,proto3" json:"web_class,omitempty"`
+	FlangeClass                int32                  `protobuf:"varint,6,opt,name=flange_class,json=flangeClass,proto3" json:"flange_class,omitempty"`
+	SectionClass               int32                  `protobuf:"varint,7,opt,name=section_class,json=sectionClass,proto3" json:"section_class,omitempty"`
+	MomentResistance           float64                `protobuf:"fixed64,8,opt,name=moment_resistance,json=momentResistance,proto3" json:"moment_resistance,omitempty"`
+	ShearArea                  float64                `protobuf:"fixed64,9,opt,name=shear_area,json=shearArea,proto3" json:"shear_area,omitempty"`
+	ShearResistance            float64                `protobuf:"fixed64,10,opt,name=shear_resistance,json=shearResistance,proto3" json:"shear_resistance,omitempty"`
+	ShearBucklingCheckRequired bool                   `protobuf:"varint,11,opt,name=shear_buckling_check_required,json=shearBucklingCheckRequired,proto3" json:"shear_buckling_check_required,omitempty"`
+	GammaM0                    float64                `protobuf:"fixed64,12,opt,name=gamma_m0,json=gammaM0,proto3" json:"gamma_m0,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *BeamResistanceResponse) Reset() {
+	*x = BeamResistanceResponse{}
+	mi := &file_steelbeam_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeamResistanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeamResistanceResponse) ProtoMessage() {}
+
+func (x *BeamResistanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeamResistanceResponse.ProtoReflect.Descriptor instead.
+func (*BeamResistanceResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BeamResistanceResponse) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *BeamResistanceResponse) GetSteelGrade() string {
+	if x != nil {
+		return x.SteelGrade
+	}
+	return ""
+}
+
+func (x *BeamResistanceResponse) GetYieldStrength() float64 {
+	if x != nil {
+		return x.YieldStrength
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetEpsilon() float64 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetWebClass() int32 {
+	if x != nil {
+		return x.WebClass
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetFlangeClass() int32 {
+	if x != nil {
+		return x.FlangeClass
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetSectionClass() int32 {
+	if x != nil {
+		return x.SectionClass
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetMomentResistance() float64 {
+	if x != nil {
+		return x.MomentResistance
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetShearArea() float64 {
+	if x != nil {
+		return x.ShearArea
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetShearResistance() float64 {
+	if x != nil {
+		return x.ShearResistance
+	}
+	return 0
+}
+
+func (x *BeamResistanceResponse) GetShearBucklingCheckRequired() bool {
+	if x != nil {
+		return x.ShearBucklingCheckRequired
+	}
+	return false
+}
+
+func (x *BeamResistanceResponse) GetGammaM0() float64 {
+	if x != nil {
+		return x.GammaM0
+	}
+	return 0
+}
+
+// Request message for lateral-torsional buckling resistance of a beam segment
+type LTBRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	SteelGrade         string                 `protobuf:"bytes,2,opt,name=steel_grade,json=steelGrade,proto3" json:"steel_grade,omitempty"`
+	// Effective length between lateral restraints in metres
+	EffectiveLength float64 `protobuf:"fixed64,3,opt,name=effective_length,json=effectiveLength,proto3" json:"effective_length,omitempty"`
+	// "shear_centre" (default), "top_flange" or "bottom_flange"
+	LoadPosition string `protobuf:"bytes,4,opt,name=load_position,json=loadPosition,proto3" json:"load_position,omitempty"`
+	// Equivalent uniform moment factor; defaults to 1.0
+	C1 float64 `protobuf:"fixed64,5,opt,name=c1,proto3" json:"c1,omitempty"`
+	// Required when the load is not applied at the shear centre
+	C2            float64 `protobuf:"fixed64,6,opt,name=c2,proto3" json:"c2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LTBRequest) Reset() {
+	*x = LTBRequest{}
+	mi := &file_steelbeam_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTBRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTBRequest) ProtoMessage() {}
+
+func (x *LTBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTBRequest.ProtoReflect.Descriptor instead.
+func (*LTBRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LTBRequest) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *LTBRequest) GetSteelGrade() string {
+	if x != nil {
+		return x.SteelGrade
+	}
+	return ""
+}
+
+func (x *LTBRequest) GetEffectiveLength() float64 {
+	if x != nil {
+		return x.EffectiveLength
+	}
+	return 0
+}
+
+func (x *LTBRequest) GetLoadPosition() string {
+	if x != nil {
+		return x.LoadPosition
+	}
+	return ""
+}
+
+func (x *LTBRequest) GetC1() float64 {
+	if x != nil {
+		return x.C1
+	}
+	return 0
+}
+
+func (x *LTBRequest) GetC2() float64 {
+	if x != nil {
+		return x.C2
+	}
+	return 0
+}
+
+// Response message with Mcr and Mb,Rd per EN 1993-1-1 6.3.2. Moments are in kNm.
+type LTBResponse struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation           string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	SteelGrade                   string                 `protobuf:"bytes,2,opt,name=steel_grade,json=steelGrade,proto3" json:"steel_grade,omitempty"`
+	YieldStrength                float64                `protobuf:"fixed64,3,opt,name=yield_strength,json=yieldStrength,proto3" json:"yield_strength,omitempty"`
+	SectionClass                 int32                  `protobuf:"varint,4,opt,name=section_class,json=sectionClass,proto3" json:"section_class,omitempty"`
+	EffectiveLength              float64                `protobuf:"fixed64,5,opt,name=effective_length,json=effectiveLength,proto3" json:"effective_length,omitempty"`
+	LoadPosition                 string                 `protobuf:"bytes,6,opt,name=load_position,json=loadPosition,proto3" json:"load_position,omitempty"`
+	C1                           float64                `protobuf:"fixed64,7,opt,name=c1,proto3" json:"c1,omitempty"`
+	C2                           float64                `protobuf:"fixed64,8,opt,name=c2,proto3" json:"c2,omitempty"`
+	CriticalMoment               float64                `protobuf:"fixed64,9,opt,name=critical_moment,json=criticalMoment,proto3" json:"critical_moment,omitempty"`
+	Slenderness                  float64                `protobuf:"fixed64,10,opt,name=slenderness,proto3" json:"slenderness,omitempty"`
+	BucklingCurve                string                 `protobuf:"bytes,11,opt,name=buckling_curve,json=bucklingCurve,proto3" json:"buckling_curve,omitempty"`
+	ImperfectionFactor           float64                `protobuf:"fixed64,12,opt,name=imperfection_factor,json=imperfectionFactor,proto3" json:"imperfection_factor,omitempty"`
+	ReductionFactor              float64                `protobuf:"fixed64,13,opt,name=reduction_factor,json=reductionFactor,proto3" json:"reduction_factor,omitempty"`
+	ModificationFactor           float64                `protobuf:"fixed64,14,opt,name=modification_factor,json=modificationFactor,proto3" json:"modification_factor,omitempty"`
+	ModifiedReductionFactor      float64                `protobuf:"fixed64,15,opt,name=modified_reduction_factor,json=modifiedReductionFactor,proto3" json:"modified_reduction_factor,omitempty"`
+	BucklingResistance           float64                `protobuf:"fixed64,16,opt,name=buckling_resistance,json=bucklingResistance,proto3" json:"buckling_resistance,omitempty"`
+	CrossSectionMomentResistance float64                `protobuf:"fixed64,17,opt,name=cross_section_moment_resistance,json=crossSectionMomentResistance,proto3" json:"cross_section_moment_resistance,omitempty"`
+	GammaM1                      float64                `protobuf:"fixed64,18,opt,name=gamma_m1,json=gammaM1,proto3" json:"gamma_m1,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *LTBResponse) Reset() {
+	*x = LTBResponse{}
+	mi := &file_steelbeam_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTBResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTBResponse) ProtoMessage() {}
+
+func (x *LTBResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTBResponse.ProtoReflect.Descriptor instead.
+func (*LTBResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LTBResponse) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *LTBResponse) GetSteelGrade() string {
+	if x != nil {
+		return x.SteelGrade
+	}
+	return ""
+}
+
+func (x *LTBResponse) GetYieldStrength() float64 {
+	if x != nil {
+		return x.YieldStrength
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetSectionClass() int32 {
+	if x != nil {
+		return x.SectionClass
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetEffectiveLength() float64 {
+	if x != nil {
+		return x.EffectiveLength
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetLoadPosition() string {
+	if x != nil {
+		return x.LoadPosition
+	}
+	return ""
+}
+
+func (x *LTBResponse) GetC1() float64 {
+	if x != nil {
+		return x.C1
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetC2() float64 {
+	if x != nil {
+		return x.C2
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetCriticalMoment() float64 {
+	if x != nil {
+		return x.CriticalMoment
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetSlenderness() float64 {
+	if x != nil {
+		return x.Slenderness
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetBucklingCurve() string {
+	if x != nil {
+		return x.BucklingCurve
+	}
+	return ""
+}
+
+func (x *LTBResponse) GetImperfectionFactor() float64 {
+	if x != nil {
+		return x.ImperfectionFactor
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetReductionFactor() float64 {
+	if x != nil {
+		return x.ReductionFactor
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetModificationFactor() float64 {
+	if x != nil {
+		return x.ModificationFactor
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetModifiedReductionFactor() float64 {
+	if x != nil {
+		return x.ModifiedReductionFactor
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetBucklingResistance() float64 {
+	if x != nil {
+		return x.BucklingResistance
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetCrossSectionMomentResistance() float64 {
+	if x != nil {
+		return x.CrossSectionMomentResistance
+	}
+	return 0
+}
+
+func (x *LTBResponse) GetGammaM1() float64 {
+	if x != nil {
+		return x.GammaM1
+	}
+	return 0
+}
+
+// Characteristic load split into permanent and variable parts
+type DesignLoad struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permanent     float64                `protobuf:"fixed64,1,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	Variable      float64                `protobuf:"fixed64,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DesignLoad) Reset() {
+	*x = DesignLoad{}
+	mi := &file_steelbeam_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DesignLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesignLoad) ProtoMessage() {}
+
+func (x *DesignLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesignLoad.ProtoReflect.Descriptor instead.
+func (*DesignLoad) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DesignLoad) GetPermanent() float64 {
+	if x != nil {
+		return x.Permanent
+	}
+	return 0
+}
+
+func (x *DesignLoad) GetVariable() float64 {
+	if x != nil {
+		return x.Variable
+	}
+	return 0
+}
+
+// Characteristic point load in kN at position metres from the left support
+type PointLoad struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      float64                `protobuf:"fixed64,1,opt,name=position,proto3" json:"position,omitempty"`
+	Permanent     float64                `protobuf:"fixed64,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	Variable      float64                `protobuf:"fixed64,3,opt,name=variable,proto3" json:"variable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PointLoad) Reset() {
+	*x = PointLoad{}
+	mi := &file_steelbeam_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointLoad) ProtoMessage() {}
+
+func (x *PointLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointLoad.ProtoReflect.Descriptor instead.
+func (*PointLoad) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PointLoad) GetPosition() float64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PointLoad) GetPermanent() float64 {
+	if x != nil {
+		return x.Permanent
+	}
+	return 0
+}
+
+func (x *PointLoad) GetVariable() float64 {
+	if x != nil {
+		return x.Variable
+	}
+	return 0
+}
+
+// Request message for a simply-supported beam design check. Span and
+// positions are in metres, UDLs in kN/m and point loads in kN.
+type SimplySupportedDesignRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Span       float64                `protobuf:"fixed64,1,opt,name=span,proto3" json:"span,omitempty"`
+	SteelGrade string                 `protobuf:"bytes,2,opt,name=steel_grade,json=steelGrade,proto3" json:"steel_grade,omitempty"`
+	Udl        *DesignLoad            `protobuf:"bytes,3,opt,name=udl,proto3" json:"udl,omitempty"`
+	PointLoads []*PointLoad           `protobuf:"bytes,4,rep,name=point_loads,json=pointLoads,proto3" json:"point_loads,omitempty"`
+	// ULS partial factors; default to 1.35 and 1.5
+	GammaG float64 `protobuf:"fixed64,5,opt,name=gamma_g,json=gammaG,proto3" json:"gamma_g,omitempty"`
+	GammaQ float64 `protobuf:"fixed64,6,opt,name=gamma_q,json=gammaQ,proto3" json:"gamma_q,omitempty"`
+	// Continuous lateral restraint to the compression flange removes the LTB check
+	ContinuousRestraint bool `protobuf:"varint,7,opt,name=continuous_restraint,json=continuousRestraint,proto3" json:"continuous_restraint,omitempty"`
+	// Intermediate lateral restraint positions in metres
+	RestraintPositions []float64 `protobuf:"fixed64,8,rep,packed,name=restraint_positions,json=restraintPositions,proto3" json:"restraint_positions,omitempty"`
+	// "shear_centre" (default), "top_flange" or "bottom_flange"
+	LoadPosition string `protobuf:"bytes,9,opt,name=load_position,json=loadPosition,proto3" json:"load_position,omitempty"`
+	// Imposed load deflection limit as span/n; defaults to 360
+	DeflectionLimit  float64 `protobuf:"fixed64,10,opt,name=deflection_limit,json=deflectionLimit,proto3" json:"deflection_limit,omitempty"`
+	IgnoreSelfWeight bool    `protobuf:"varint,11,opt,name=ignore_self_weight,json=ignoreSelfWeight,proto3" json:"ignore_self_weight,omitempty"`
+	// Candidate sections; every beam is checked when empty
+	Sections      []string `protobuf:"bytes,12,rep,name=sections,proto3" json:"sections,omitempty"`
+	OnlyPassing   bool     `protobuf:"varint,13,opt,name=only_passing,json=onlyPassing,proto3" json:"only_passing,omitempty"`
+	Limit         int32    `protobuf:"varint,14,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimplySupportedDesignRequest) Reset() {
+	*x = SimplySupportedDesignRequest{}
+	mi := &file_steelbeam_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimplySupportedDesignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplySupportedDesignRequest) ProtoMessage() {}
+
+func (x *SimplySupportedDesignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplySupportedDesignRequest.ProtoReflect.Descriptor instead.
+func (*SimplySupportedDesignRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SimplySupportedDesignRequest) GetSpan() float64 {
+	if x != nil {
+		return x.Span
+	}
+	return 0
+}
+
+func (x *SimplySupportedDesignRequest) GetSteelGrade() string {
+	if x != nil {
+		return x.SteelGrade
+	}
+	return ""
+}
+
+func (x *SimplySupportedDesignRequest) GetUdl() *DesignLoad {
+	if x != nil {
+		return x.Udl
+	}
+	return nil
+}
+
+func (x *SimplySupportedDesignRequest) GetPointLoads() []*PointLoad {
+	if x != nil {
+		return x.PointLoads
+	}
+	return nil
+}
+
+func (x *SimplySupportedDesignRequest) GetGammaG() float64 {
+	if x != nil {
+		return x.GammaG
+	}
+	return 0
+}
+
+func (x *SimplySupportedDesignRequest) GetGammaQ() float64 {
+	if x != nil {
+		return x.GammaQ
+	}
+	return 0
+}
+
+func (x *SimplySupportedDesignRequest) GetContinuousRestraint() bool {
+	if x != nil {
+		return x.ContinuousRestraint
+	}
+	return false
+}
+
+func (x *SimplySupportedDesignRequest) GetRestraintPositions() []float64 {
+	if x != nil {
+		return x.RestraintPositions
+	}
+	return nil
+}
+
+func (x *SimplySupportedDesignRequest) GetLoadPosition() string {
+	if x != nil {
+		return x.LoadPosition
+	}
+	return ""
+}
+
+func (x *SimplySupportedDesignRequest) GetDeflectionLimit() float64 {
+	if x != nil {
+		return x.DeflectionLimit
+	}
+	return 0
+}
+
+func (x *SimplySupportedDesignRequest) GetIgnoreSelfWeight() bool {
+	if x != nil {
+		return x.IgnoreSelfWeight
+	}
+	return false
+}
+
+func (x *SimplySupportedDesignRequest) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SimplySupportedDesignRequest) GetOnlyPassing() bool {
+	if x != nil {
+		return x.OnlyPassing
+	}
+	return false
+}
+
+func (x *SimplySupportedDesignRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A single limit state check with utilisation = design_value / resistance
+type DesignCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DesignValue   float64                `protobuf:"fixed64,1,opt,name=design_value,json=designValue,proto3" json:"design_value,omitempty"`
+	Resistance    float64                `protobuf:"fixed64,2,opt,name=resistance,proto3" json:"resistance,omitempty"`
+	Utilisation   float64                `protobuf:"fixed64,3,opt,name=utilisation,proto3" json:"utilisation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DesignCheck) Reset() {
+	*x = DesignCheck{}
+	mi := &file_steelbeam_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DesignCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesignCheck) ProtoMessage() {}
+
+func (x *DesignCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesignCheck.ProtoReflect.Descriptor instead.
+func (*DesignCheck) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DesignCheck) GetDesignValue() float64 {
+	if x != nil {
+		return x.DesignValue
+	}
+	return 0
+}
+
+func (x *DesignCheck) GetResistance() float64 {
+	if x != nil {
+		return x.Resistance
+	}
+	return 0
+}
+
+func (x *DesignCheck) GetUtilisation() float64 {
+	if x != nil {
+		return x.Utilisation
+	}
+	return 0
+}
+
+// Lateral-torsional buckling check of the governing segment
+type LTBCheck struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Check           *DesignCheck           `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	SegmentStart    float64                `protobuf:"fixed64,2,opt,name=segment_start,json=segmentStart,proto3" json:"segment_start,omitempty"`
+	SegmentEnd      float64                `protobuf:"fixed64,3,opt,name=segment_end,json=segmentEnd,proto3" json:"segment_end,omitempty"`
+	EffectiveLength float64                `protobuf:"fixed64,4,opt,name=effective_length,json=effectiveLength,proto3" json:"effective_length,omitempty"`
+	C1              float64                `protobuf:"fixed64,5,opt,name=c1,proto3" json:"c1,omitempty"`
+	C2              float64                `protobuf:"fixed64,6,opt,name=c2,proto3" json:"c2,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LTBCheck) Reset() {
+	*x = LTBCheck{}
+	mi := &file_steelbeam_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTBCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTBCheck) ProtoMessage() {}
+
+func (x *LTBCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTBCheck.ProtoReflect.Descriptor instead.
+func (*LTBCheck) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LTBCheck) GetCheck() *DesignCheck {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *LTBCheck) GetSegmentStart() float64 {
+	if x != nil {
+		return x.SegmentStart
+	}
+	return 0
+}
+
+func (x *LTBCheck) GetSegmentEnd() float64 {
+	if x != nil {
+		return x.SegmentEnd
+	}
+	return 0
+}
+
+func (x *LTBCheck) GetEffectiveLength() float64 {
+	if x != nil {
+		return x.EffectiveLength
+	}
+	return 0
+}
+
+func (x *LTBCheck) GetC1() float64 {
+	if x != nil {
+		return x.C1
+	}
+	return 0
+}
+
+func (x *LTBCheck) GetC2() float64 {
+	if x != nil {
+		return x.C2
+	}
+	return 0
+}
+
+// Design check result for one section. Moments are in kNm, shears in kN and
+// deflections in mm. error is set when the section could not be checked.
+type SectionDesignResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SectionDesignation string                 `protobuf:"bytes,1,opt,name=section_designation,json=sectionDesignation,proto3" json:"section_designation,omitempty"`
+	MassPerMetre       float64                `protobuf:"fixed64,2,opt,name=mass_per_metre,json=massPerMetre,proto3" json:"mass_per_metre,omitempty"`
+	SectionClass       int32                  `protobuf:"varint,3,opt,name=section_class,json=sectionClass,proto3" json:"section_class,omitempty"`
+	Passes             bool                   `protobuf:"varint,4,opt,name=passes,proto3" json:"passes,omitempty"`
+	Governing          string                 `protobuf:"bytes,5,opt,name=governing,proto3" json:"governing,omitempty"`
+	MaxUtilisation     float64                `protobuf:"fixed64,6,opt,name=max_utilisation,json=maxUtilisation,proto3" json:"max_utilisation,omitempty"`
+	Bending            *DesignCheck           `protobuf:"bytes,7,opt,name=bending,proto3" json:"bending,omitempty"`
+	Shear              *DesignCheck           `protobuf:"bytes,8,opt,name=shear,proto3" json:"shear,omitempty"`
+	HighShear          bool                   `protobuf:"varint,9,opt,name=high_shear,json=highShear,proto3" json:"high_shear,omitempty"`
+	Ltb                *LTBCheck              `protobuf:"bytes,10,opt,name=ltb,proto3" json:"ltb,omitempty"`
+	Deflection         *DesignCheck           `protobuf:"bytes,11,opt,name=deflection,proto3" json:"deflection,omitempty"`
+	Error              string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	// Shear where the moment is greatest, and the 6.2.8 reduction factor
+	// applied to the bending resistance when high_shear is set
+	ShearAtMaxMoment float64 `protobuf:"fixed64,13,opt,name=shear_at_max_moment,json=shearAtMaxMoment,proto3" json:"shear_at_max_moment,omitempty"`
+	ShearReduction   float64 `protobuf:"fixed64,14,opt,name=shear_reduction,json=shearReduction,proto3" json:"shear_reduction,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SectionDesignResult) Reset() {
+	*x = SectionDesignResult{}
+	mi := &file_steelbeam_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionDesignResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionDesignResult) ProtoMessage() {}
+
+func (x *SectionDesignResult) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionDesignResult.ProtoReflect.Descriptor instead.
+func (*SectionDesignResult) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SectionDesignResult) GetSectionDesignation() string {
+	if x != nil {
+		return x.SectionDesignation
+	}
+	return ""
+}
+
+func (x *SectionDesignResult) GetMassPerMetre() float64 {
+	if x != nil {
+		return x.MassPerMetre
+	}
+	return 0
+}
+
+func (x *SectionDesignResult) GetSectionClass() int32 {
+	if x != nil {
+		return x.SectionClass
+	}
+	return 0
+}
+
+func (x *SectionDesignResult) GetPasses() bool {
+	if x != nil {
+		return x.Passes
+	}
+	return false
+}
+
+func (x *SectionDesignResult) GetGoverning() string {
+	if x != nil {
+		return x.Governing
+	}
+	return ""
+}
+
+func (x *SectionDesignResult) GetMaxUtilisation() float64 {
+	if x != nil {
+		return x.MaxUtilisation
+	}
+	return 0
+}
+
+func (x *SectionDesignResult) GetBending() *DesignCheck {
+	if x != nil {
+		return x.Bending
+	}
+	return nil
+}
+
+func (x *SectionDesignResult) GetShear() *DesignCheck {
+	if x != nil {
+		return x.Shear
+	}
+	return nil
+}
+
+func (x *SectionDesignResult) GetHighShear() bool {
+	if x != nil {
+		return x.HighShear
+	}
+	return false
+}
+
+func (x *SectionDesignResult) GetLtb() *LTBCheck {
+	if x != nil {
+		return x.Ltb
+	}
+	return nil
+}
+
+func (x *SectionDesignResult) GetDeflection() *DesignCheck {
+	if x != nil {
+		return x.Deflection
+	}
+	return nil
+}
+
+func (x *SectionDesignResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SectionDesignResult) GetShearAtMaxMoment() float64 {
+	if x != nil {
+		return x.ShearAtMaxMoment
+	}
+	return 0
+}
+
+func (x *SectionDesignResult) GetShearReduction() float64 {
+	if x != nil {
+		return x.ShearReduction
+	}
+	return 0
+}
+
+// Nominal strength in N/mm² for elements up to max_thickness mm thick
+type StrengthBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxThickness  float64                `protobuf:"fixed64,1,opt,name=max_thickness,json=maxThickness,proto3" json:"max_thickness,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrengthBand) Reset() {
+	*x = StrengthBand{}
+	mi := &file_steelbeam_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrengthBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrengthBand) ProtoMessage() {}
+
+func (x *StrengthBand) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrengthBand.ProtoReflect.Descriptor instead.
+func (*StrengthBand) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StrengthBand) GetMaxThickness() float64 {
+	if x != nil {
+		return x.MaxThickness
+	}
+	return 0
+}
+
+func (x *StrengthBand) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Structural steel grade with thickness-dependent fy and fu per EN 10025
+type Material struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Grade            string                 `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Standard         string                 `protobuf:"bytes,2,opt,name=standard,proto3" json:"standard,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	YieldStrength    []*StrengthBand        `protobuf:"bytes,4,rep,name=yield_strength,json=yieldStrength,proto3" json:"yield_strength,omitempty"`
+	UltimateStrength []*StrengthBand        `protobuf:"bytes,5,rep,name=ultimate_strength,json=ultimateStrength,proto3" json:"ultimate_strength,omitempty"`
+	ElasticModulus   float64                `protobuf:"fixed64,6,opt,name=elastic_modulus,json=elasticModulus,proto3" json:"elastic_modulus,omitempty"`
+	ShearModulus     float64                `protobuf:"fixed64,7,opt,name=shear_modulus,json=shearModulus,proto3" json:"shear_modulus,omitempty"`
+	PoissonsRatio    float64                `protobuf:"fixed64,8,opt,name=poissons_ratio,json=poissonsRatio,proto3" json:"poissons_ratio,omitempty"`
+	Density          float64                `protobuf:"fixed64,9,opt,name=density,proto3" json:"density,omitempty"`
+	ThermalExpansion float64                `protobuf:"fixed64,10,opt,name=thermal_expansion,json=thermalExpansion,proto3" json:"thermal_expansion,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Material) Reset() {
+	*x = Material{}
+	mi := &file_steelbeam_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Material) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Material) ProtoMessage() {}
+
+func (x *Material) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Material.ProtoReflect.Descriptor instead.
+func (*Material) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Material) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *Material) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *Material) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Material) GetYieldStrength() []*StrengthBand {
+	if x != nil {
+		return x.YieldStrength
+	}
+	return nil
+}
+
+func (x *Material) GetUltimateStrength() []*StrengthBand {
+	if x != nil {
+		return x.UltimateStrength
+	}
+	return nil
+}
+
+func (x *Material) GetElasticModulus() float64 {
+	if x != nil {
+		return x.ElasticModulus
+	}
+	return 0
+}
+
+func (x *Material) GetShearModulus() float64 {
+	if x != nil {
+		return x.ShearModulus
+	}
+	return 0
+}
+
+func (x *Material) GetPoissonsRatio() float64 {
+	if x != nil {
+		return x.PoissonsRatio
+	}
+	return 0
+}
+
+func (x *Material) GetDensity() float64 {
+	if x != nil {
+		return x.Density
+	}
+	return 0
+}
+
+func (x *Material) GetThermalExpansion() float64 {
+	if x != nil {
+		return x.ThermalExpansion
+	}
+	return 0
+}
+
+// fy and fu of a grade at a given element thickness
+type MaterialStrength struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Grade            string                 `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Thickness        float64                `protobuf:"fixed64,2,opt,name=thickness,proto3" json:"thickness,omitempty"`
+	YieldStrength    float64                `protobuf:"fixed64,3,opt,name=yield_strength,json=yieldStrength,proto3" json:"yield_strength,omitempty"`
+	UltimateStrength float64                `protobuf:"fixed64,4,opt,name=ultimate_strength,json=ultimateStrength,proto3" json:"ultimate_strength,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MaterialStrength) Reset() {
+	*x = MaterialStrength{}
+	mi := &file_steelbeam_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialStrength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialStrength) ProtoMessage() {}
+
+func (x *MaterialStrength) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialStrength.ProtoReflect.Descriptor instead.
+func (*MaterialStrength) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MaterialStrength) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *MaterialStrength) GetThickness() float64 {
+	if x != nil {
+		return x.Thickness
+	}
+	return 0
+}
+
+func (x *MaterialStrength) GetYieldStrength() float64 {
+	if x != nil {
+		return x.YieldStrength
+	}
+	return 0
+}
+
+func (x *MaterialStrength) GetUltimateStrength() float64 {
+	if x != nil {
+		return x.UltimateStrength
+	}
+	return 0
+}
+
+// Request message for steel grades. grade optionally selects one grade by
+// name (e.g. "S355J2"); thickness in mm, when set, resolves fy and fu.
+type GetMaterialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grade         string                 `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Thickness     *float64               `protobuf:"fixed64,2,opt,name=thickness,proto3,oneof" json:"thickness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialsRequest) Reset() {
+	*x = GetMaterialsRequest{}
+	mi := &file_steelbeam_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialsRequest) ProtoMessage() {}
+
+func (x *GetMaterialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialsRequest.ProtoReflect.Descriptor instead.
+func (*GetMaterialsRequest) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetMaterialsRequest) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *GetMaterialsRequest) GetThickness() float64 {
+	if x != nil && x.Thickness != nil {
+		return *x.Thickness
+	}
+	return 0
+}
+
+// Response message for steel grades; strengths is set when thickness was given
+type GetMaterialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materials     []*Material            `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	Strengths     []*MaterialStrength    `protobuf:"bytes,2,rep,name=strengths,proto3" json:"strengths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialsResponse) Reset() {
+	*x = GetMaterialsResponse{}
+	mi := &file_steelbeam_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialsResponse) ProtoMessage() {}
+
+func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_steelbeam_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialsResponse.ProtoReflect.Descriptor instead.
+func (*GetMaterialsResponse) Descriptor() ([]byte, []int) {
+	return file_steelbeam_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetMaterialsResponse) GetMaterials() []*Material {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *GetMaterialsResponse) GetStrengths() []*MaterialStrength {
+	if x != nil {
+		return x.Strengths
+	}
+	return nil
+}
+
+var File_steelbeam_proto protoreflect.FileDescriptor
+
+const file_steelbeam_proto_rawDesc = "" +
+	"\n" +
+	"\x0fsteelbeam.proto\x12\tsteelbeam\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe7\n" +
+	"\n" +
+	"\tSteelBeam\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12$\n" +
+	"\x0emass_per_metre\x18\x02 \x01(\x01R\fmassPerMetre\x12(\n" +
+	"\x10depth_of_section\x18\x03 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x04 \x01(\x01R\x0ewidthOfSection\x12#\n" +
+	"\rthickness_web\x18\x05 \x01(\x01R\fthicknessWeb\x12)\n" +
+	"\x10thickness_flange\x18\x06 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
+	"\vroot_radius\x18\a \x01(\x01R\n" +
+	"rootRadius\x122\n" +
+	"\x15depth_between_fillets\x18\b \x01(\x01R\x13depthBetweenFillets\x12@\n" +
+	"\x1dratios_for_local_buckling_web\x18\t \x01(\x01R\x19ratiosForLocalBucklingWeb\x12F\n" +
+	" ratios_for_local_buckling_flange\x18\n" +
+	" \x01(\x01R\x1cratiosForLocalBucklingFlange\x12#\n" +
+	"\rend_clearance\x18\v \x01(\x01R\fendClearance\x12\x14\n" +
+	"\x05notch\x18\f \x01(\x01R\x05notch\x12;\n" +
+	"\x1adimensions_for_detailing_n\x18\r \x01(\x01R\x17dimensionsForDetailingN\x123\n" +
+	"\x16surface_area_per_metre\x18\x0e \x01(\x01R\x13surfaceAreaPerMetre\x123\n" +
+	"\x16surface_area_per_tonne\x18\x0f \x01(\x01R\x13surfaceAreaPerTonne\x12=\n" +
+	"\x1csecond_moment_of_area_axis_y\x18\x10 \x01(\x01R\x17secondMomentOfAreaAxisY\x12=\n" +
+	"\x1csecond_moment_of_area_axis_z\x18\x11 \x01(\x01R\x17secondMomentOfAreaAxisZ\x128\n" +
+	"\x19radius_of_gyration_axis_y\x18\x12 \x01(\x01R\x15radiusOfGyrationAxisY\x128\n" +
+	"\x19radius_of_gyration_axis_z\x18\x13 \x01(\x01R\x15radiusOfGyrationAxisZ\x123\n" +
+	"\x16elastic_modulus_axis_y\x18\x14 \x01(\x01R\x13elasticModulusAxisY\x123\n" +
+	"\x16elastic_modulus_axis_z\x18\x15 \x01(\x01R\x13elasticModulusAxisZ\x123\n" +
+	"\x16plastic_modulus_axis_y\x18\x16 \x01(\x01R\x13plasticModulusAxisY\x123\n" +
+	"\x16plastic_modulus_axis_z\x18\x17 \x01(\x01R\x13plasticModulusAxisZ\x12-\n" +
+	"\x12buckling_parameter\x18\x18 \x01(\x01R\x11bucklingParameter\x12'\n" +
+	"\x0ftorsional_index\x18\x19 \x01(\x01R\x0etorsionalIndex\x12)\n" +
+	"\x10warping_constant\x18\x1a \x01(\x01R\x0fwarpingConstant\x12-\n" +
+	"\x12torsional_constant\x18\x1b \x01(\x01R\x11torsionalConstant\x12&\n" +
+	"\x0farea_of_section\x18\x1c \x01(\x01R\rareaOfSection\"e\n" +
+	"\x0fBeamRangeFilter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x15\n" +
+	"\x03min\x18\x02 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x03 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xc4\x01\n" +
+	"\x0fGetBeamsRequest\x124\n" +
+	"\afilters\x18\x01 \x03(\v2\x1a.steelbeam.BeamRangeFilterR\afilters\x12\x17\n" +
+	"\asort_by\x18\x02 \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x03 \x01(\bR\n" +
+	"descending\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05units\x18\x06 \x01(\tR\x05units\"\x80\x01\n" +
+	"\x10GetBeamsResponse\x12*\n" +
+	"\x05beams\x18\x01 \x03(\v2\x14.steelbeam.SteelBeamR\x05beams\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_offset\x18\x03 \x01(\x05R\n" +
+	"nextOffset\"W\n" +
+	"\x0eGetBeamRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\tR\x05units\"Q\n" +
+	"\x0fGetBeamResponse\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\"d\n" +
+	"\x11CreateBeamRequest\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12%\n" +
+	"\x0ederive_missing\x18\x02 \x01(\bR\rderiveMissing\"r\n" +
+	"\x12CreateBeamResponse\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x96\x02\n" +
+	"\x1eDeriveSectionPropertiesRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12(\n" +
+	"\x10depth_of_section\x18\x02 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x03 \x01(\x01R\x0ewidthOfSection\x12#\n" +
+	"\rthickness_web\x18\x04 \x01(\x01R\fthicknessWeb\x12)\n" +
+	"\x10thickness_flange\x18\x05 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
+	"\vroot_radius\x18\x06 \x01(\x01R\n" +
+	"rootRadius\"K\n" +
+	"\x1fDeriveSectionPropertiesResponse\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\"c\n" +
+	"\rImportOptions\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12%\n" +
+	"\x0ederive_missing\x18\x03 \x01(\bR\rderiveMissing\"~\n" +
+	"\x12ImportBeamsRequest\x124\n" +
+	"\aoptions\x18\x01 \x01(\v2\x18.steelbeam.ImportOptionsH\x00R\aoptions\x12*\n" +
+	"\x04beam\x18\x02 \x01(\v2\x14.steelbeam.SteelBeamH\x00R\x04beamB\x06\n" +
+	"\x04item\"<\n" +
+	"\n" +
+	"FieldError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb1\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12/\n" +
+	"\x13section_designation\x18\x02 \x01(\tR\x12sectionDesignation\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12-\n" +
+	"\x06errors\x18\x05 \x03(\v2\x15.steelbeam.FieldErrorR\x06errors\"\xd4\x01\n" +
+	"\x13ImportBeamsResponse\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x05 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12.\n" +
+	"\x04rows\x18\a \x03(\v2\x1a.steelbeam.ImportRowResultR\x04rows\"n\n" +
+	"\x11UpdateBeamRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12(\n" +
+	"\x04beam\x18\x02 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\"r\n" +
+	"\x12UpdateBeamResponse\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"D\n" +
+	"\x11DeleteBeamRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\"H\n" +
+	"\x12DeleteBeamResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x11WatchBeamsRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xf6\x01\n" +
+	"\tBeamEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12/\n" +
+	"\x13section_designation\x18\x02 \x01(\tR\x12sectionDesignation\x12(\n" +
+	"\x04beam\x18\x04 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtJ\x04\b\x03\x10\x04R\x14previous_designation\"\xd3\x01\n" +
+	"\x15GetStockStatusRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bpostcode\x18\x02 \x01(\tR\bpostcode\x12\x1a\n" +
+	"\bsupplier\x18\x03 \x01(\tR\bsupplier\x12\x1b\n" +
+	"\tbranch_id\x18\x04 \x01(\tR\bbranchId\x12!\n" +
+	"\fbranch_limit\x18\x05 \x01(\x05R\vbranchLimit\x12#\n" +
+	"\rwithin_radius\x18\x06 \x01(\x01R\fwithinRadius\"h\n" +
+	"\vBranchStock\x12\x1b\n" +
+	"\tbranch_id\x18\x01 \x01(\tR\bbranchId\x12\x1f\n" +
+	"\vstock_level\x18\x02 \x01(\x01R\n" +
+	"stockLevel\x12\x1b\n" +
+	"\tstock_uom\x18\x03 \x01(\tR\bstockUom\"\xa3\x01\n" +
+	"\rSupplierStock\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x122\n" +
+	"\bbranches\x18\x04 \x03(\v2\x16.steelbeam.BranchStockR\bbranches\x12\x14\n" +
+	"\x05cache\x18\x05 \x01(\tR\x05cache\"\xbd\x02\n" +
+	"\x16GetStockStatusResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bpostcode\x18\x02 \x01(\tR\bpostcode\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1a\n" +
+	"\bsupplier\x18\x06 \x01(\tR\bsupplier\x126\n" +
+	"\tsuppliers\x18\a \x03(\v2\x18.steelbeam.SupplierStockR\tsuppliers\x122\n" +
+	"\bbranches\x18\b \x03(\v2\x16.steelbeam.BranchStockR\bbranches\x12\x14\n" +
+	"\x05cache\x18\t \x01(\tR\x05cache\"\xf0\a\n" +
+	"\aSection\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x16\n" +
+	"\x06family\x18\x02 \x01(\tR\x06family\x12$\n" +
+	"\x0emass_per_metre\x18\x03 \x01(\x01R\fmassPerMetre\x12&\n" +
+	"\x0farea_of_section\x18\x04 \x01(\x01R\rareaOfSection\x123\n" +
+	"\x16surface_area_per_metre\x18\x05 \x01(\x01R\x13surfaceAreaPerMetre\x12=\n" +
+	"\x1csecond_moment_of_area_axis_y\x18\x06 \x01(\x01R\x17secondMomentOfAreaAxisY\x12=\n" +
+	"\x1csecond_moment_of_area_axis_z\x18\a \x01(\x01R\x17secondMomentOfAreaAxisZ\x128\n" +
+	"\x19radius_of_gyration_axis_y\x18\b \x01(\x01R\x15radiusOfGyrationAxisY\x128\n" +
+	"\x19radius_of_gyration_axis_z\x18\t \x01(\x01R\x15radiusOfGyrationAxisZ\x123\n" +
+	"\x16elastic_modulus_axis_y\x18\n" +
+	" \x01(\x01R\x13elasticModulusAxisY\x123\n" +
+	"\x16elastic_modulus_axis_z\x18\v \x01(\x01R\x13elasticModulusAxisZ\x123\n" +
+	"\x16plastic_modulus_axis_y\x18\f \x01(\x01R\x13plasticModulusAxisY\x123\n" +
+	"\x16plastic_modulus_axis_z\x18\r \x01(\x01R\x13plasticModulusAxisZ\x12-\n" +
+	"\x12torsional_constant\x18\x0e \x01(\x01R\x11torsionalConstant\x12<\n" +
+	"\ti_section\x18\x14 \x01(\v2\x1d.steelbeam.ISectionPropertiesH\x00R\biSection\x128\n" +
+	"\achannel\x18\x15 \x01(\v2\x1c.steelbeam.ChannelPropertiesH\x00R\achannel\x122\n" +
+	"\x05angle\x18\x16 \x01(\v2\x1a.steelbeam.AnglePropertiesH\x00R\x05angle\x12<\n" +
+	"\x06hollow\x18\x17 \x01(\v2\".steelbeam.HollowSectionPropertiesH\x00R\x06hollow\x12,\n" +
+	"\x03tee\x18\x18 \x01(\v2\x18.steelbeam.TeePropertiesH\x00R\x03teeB\f\n" +
+	"\n" +
+	"properties\"\x90\x03\n" +
+	"\x12ISectionProperties\x12(\n" +
+	"\x10depth_of_section\x18\x01 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x02 \x01(\x01R\x0ewidthOfSection\x12#\n" +
+	"\rthickness_web\x18\x03 \x01(\x01R\fthicknessWeb\x12)\n" +
+	"\x10thickness_flange\x18\x04 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
+	"\vroot_radius\x18\x05 \x01(\x01R\n" +
+	"rootRadius\x122\n" +
+	"\x15depth_between_fillets\x18\x06 \x01(\x01R\x13depthBetweenFillets\x12-\n" +
+	"\x12buckling_parameter\x18\a \x01(\x01R\x11bucklingParameter\x12'\n" +
+	"\x0ftorsional_index\x18\b \x01(\x01R\x0etorsionalIndex\x12)\n" +
+	"\x10warping_constant\x18\t \x01(\x01R\x0fwarpingConstant\"\xa1\x03\n" +
+	"\x11ChannelProperties\x12(\n" +
+	"\x10depth_of_section\x18\x01 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x02 \x01(\x01R\x0ewidthOfSection\x12#\n" +
+	"\rthickness_web\x18\x03 \x01(\x01R\fthicknessWeb\x12)\n" +
+	"\x10thickness_flange\x18\x04 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
+	"\vroot_radius\x18\x05 \x01(\x01R\n" +
+	"rootRadius\x122\n" +
+	"\x15depth_between_fillets\x18\x06 \x01(\x01R\x13depthBetweenFillets\x12+\n" +
+	"\x11centroid_distance\x18\a \x01(\x01R\x10centroidDistance\x12;\n" +
+	"\x1ashear_centre_from_centroid\x18\b \x01(\x01R\x17shearCentreFromCentroid\x12)\n" +
+	"\x10warping_constant\x18\t \x01(\x01R\x0fwarpingConstant\"\xb0\x04\n" +
+	"\x0fAngleProperties\x12&\n" +
+	"\x0fleg_length_long\x18\x01 \x01(\x01R\rlegLengthLong\x12(\n" +
+	"\x10leg_length_short\x18\x02 \x01(\x01R\x0elegLengthShort\x12\x1c\n" +
+	"\tthickness\x18\x03 \x01(\x01R\tthickness\x12\x1f\n" +
+	"\vroot_radius\x18\x04 \x01(\x01R\n" +
+	"rootRadius\x12\x1d\n" +
+	"\n" +
+	"toe_radius\x18\x05 \x01(\x01R\ttoeRadius\x12.\n" +
+	"\x13centroid_distance_y\x18\x06 \x01(\x01R\x11centroidDistanceY\x12.\n" +
+	"\x13centroid_distance_z\x18\a \x01(\x01R\x11centroidDistanceZ\x12=\n" +
+	"\x1csecond_moment_of_area_axis_u\x18\b \x01(\x01R\x17secondMomentOfAreaAxisU\x12=\n" +
+	"\x1csecond_moment_of_area_axis_v\x18\t \x01(\x01R\x17secondMomentOfAreaAxisV\x128\n" +
+	"\x19radius_of_gyration_axis_u\x18\n" +
+	" \x01(\x01R\x15radiusOfGyrationAxisU\x128\n" +
+	"\x19radius_of_gyration_axis_v\x18\v \x01(\x01R\x15radiusOfGyrationAxisV\x12\x1b\n" +
+	"\ttan_alpha\x18\f \x01(\x01R\btanAlpha\"\xec\x01\n" +
+	"\x17HollowSectionProperties\x12)\n" +
+	"\x10outside_diameter\x18\x01 \x01(\x01R\x0foutsideDiameter\x12(\n" +
+	"\x10depth_of_section\x18\x02 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x03 \x01(\x01R\x0ewidthOfSection\x12%\n" +
+	"\x0ewall_thickness\x18\x04 \x01(\x01R\rwallThickness\x12+\n" +
+	"\x11torsional_modulus\x18\x05 \x01(\x01R\x10torsionalModulus\"\x81\x02\n" +
+	"\rTeeProperties\x12(\n" +
+	"\x10depth_of_section\x18\x01 \x01(\x01R\x0edepthOfSection\x12(\n" +
+	"\x10width_of_section\x18\x02 \x01(\x01R\x0ewidthOfSection\x12#\n" +
+	"\rthickness_web\x18\x03 \x01(\x01R\fthicknessWeb\x12)\n" +
+	"\x10thickness_flange\x18\x04 \x01(\x01R\x0fthicknessFlange\x12\x1f\n" +
+	"\vroot_radius\x18\x05 \x01(\x01R\n" +
+	"rootRadius\x12+\n" +
+	"\x11centroid_distance\x18\x06 \x01(\x01R\x10centroidDistance\",\n" +
+	"\x12GetSectionsRequest\x12\x16\n" +
+	"\x06family\x18\x01 \x01(\tR\x06family\"E\n" +
+	"\x13GetSectionsResponse\x12.\n" +
+	"\bsections\x18\x01 \x03(\v2\x12.steelbeam.SectionR\bsections\"D\n" +
+	"\x11GetSectionRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\"X\n" +
+	"\x12GetSectionResponse\x12,\n" +
+	"\asection\x18\x01 \x01(\v2\x12.steelbeam.SectionR\asection\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\"\xb3\x02\n" +
+	"\x11SelectBeamRequest\x12F\n" +
+	"\bminimums\x18\x01 \x03(\v2*.steelbeam.SelectBeamRequest.MinimumsEntryR\bminimums\x12F\n" +
+	"\bmaximums\x18\x02 \x03(\v2*.steelbeam.SelectBeamRequest.MaximumsEntryR\bmaximums\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x1a;\n" +
+	"\rMinimumsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a;\n" +
+	"\rMaximumsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x13GoverningConstraint\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05bound\x18\x02 \x01(\tR\x05bound\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12 \n" +
+	"\vutilisation\x18\x05 \x01(\x01R\vutilisation\"w\n" +
+	"\rBeamCandidate\x12(\n" +
+	"\x04beam\x18\x01 \x01(\v2\x14.steelbeam.SteelBeamR\x04beam\x12<\n" +
+	"\tgoverning\x18\x02 \x01(\v2\x1e.steelbeam.GoverningConstraintR\tgoverning\"N\n" +
+	"\x12SelectBeamResponse\x128\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x18.steelbeam.BeamCandidateR\n" +
+	"candidates\"i\n" +
+	"\x15BeamResistanceRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x1f\n" +
+	"\vsteel_grade\x18\x02 \x01(\tR\n" +
+	"steelGrade\"\xe5\x03\n" +
+	"\x16BeamResistanceResponse\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x1f\n" +
+	"\vsteel_grade\x18\x02 \x01(\tR\n" +
+	"steelGrade\x12%\n" +
+	"\x0eyield_strength\x18\x03 \x01(\x01R\ryieldStrength\x12\x18\n" +
+	"\aepsilon\x18\x04 \x01(\x01R\aepsilon\x12\x1b\n" +
+	"\tweb_class\x18\x05 \x01(\x05R\bwebClass\x12!\n" +
+	"\fflange_class\x18\x06 \x01(\x05R\vflangeClass\x12#\n" +
+	"\rsection_class\x18\a \x01(\x05R\fsectionClass\x12+\n" +
+	"\x11moment_resistance\x18\b \x01(\x01R\x10momentResistance\x12\x1d\n" +
+	"\n" +
+	"shear_area\x18\t \x01(\x01R\tshearArea\x12)\n" +
+	"\x10shear_resistance\x18\n" +
+	" \x01(\x01R\x0fshearResistance\x12A\n" +
+	"\x1dshear_buckling_check_required\x18\v \x01(\bR\x1ashearBucklingCheckRequired\x12\x19\n" +
+	"\bgamma_m0\x18\f \x01(\x01R\agammaM0\"\xce\x01\n" +
+	"\n" +
+	"LTBRequest\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x1f\n" +
+	"\vsteel_grade\x18\x02 \x01(\tR\n" +
+	"steelGrade\x12)\n" +
+	"\x10effective_length\x18\x03 \x01(\x01R\x0feffectiveLength\x12#\n" +
+	"\rload_position\x18\x04 \x01(\tR\floadPosition\x12\x0e\n" +
+	"\x02c1\x18\x05 \x01(\x01R\x02c1\x12\x0e\n" +
+	"\x02c2\x18\x06 \x01(\x01R\x02c2\"\xe9\x05\n" +
+	"\vLTBResponse\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12\x1f\n" +
+	"\vsteel_grade\x18\x02 \x01(\tR\n" +
+	"steelGrade\x12%\n" +
+	"\x0eyield_strength\x18\x03 \x01(\x01R\ryieldStrength\x12#\n" +
+	"\rsection_class\x18\x04 \x01(\x05R\fsectionClass\x12)\n" +
+	"\x10effective_length\x18\x05 \x01(\x01R\x0feffectiveLength\x12#\n" +
+	"\rload_position\x18\x06 \x01(\tR\floadPosition\x12\x0e\n" +
+	"\x02c1\x18\a \x01(\x01R\x02c1\x12\x0e\n" +
+	"\x02c2\x18\b \x01(\x01R\x02c2\x12'\n" +
+	"\x0fcritical_moment\x18\t \x01(\x01R\x0ecriticalMoment\x12 \n" +
+	"\vslenderness\x18\n" +
+	" \x01(\x01R\vslenderness\x12%\n" +
+	"\x0ebuckling_curve\x18\v \x01(\tR\rbucklingCurve\x12/\n" +
+	"\x13imperfection_factor\x18\f \x01(\x01R\x12imperfectionFactor\x12)\n" +
+	"\x10reduction_factor\x18\r \x01(\x01R\x0freductionFactor\x12/\n" +
+	"\x13modification_factor\x18\x0e \x01(\x01R\x12modificationFactor\x12:\n" +
+	"\x19modified_reduction_factor\x18\x0f \x01(\x01R\x17modifiedReductionFactor\x12/\n" +
+	"\x13buckling_resistance\x18\x10 \x01(\x01R\x12bucklingResistance\x12E\n" +
+	"\x1fcross_section_moment_resistance\x18\x11 \x01(\x01R\x1ccrossSectionMomentResistance\x12\x19\n" +
+	"\bgamma_m1\x18\x12 \x01(\x01R\agammaM1\"F\n" +
+	"\n" +
+	"DesignLoad\x12\x1c\n" +
+	"\tpermanent\x18\x01 \x01(\x01R\tpermanent\x12\x1a\n" +
+	"\bvariable\x18\x02 \x01(\x01R\bvariable\"a\n" +
+	"\tPointLoad\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x01R\bposition\x12\x1c\n" +
+	"\tpermanent\x18\x02 \x01(\x01R\tpermanent\x12\x1a\n" +
+	"\bvariable\x18\x03 \x01(\x01R\bvariable\"\x9c\x04\n" +
+	"\x1cSimplySupportedDesignRequest\x12\x12\n" +
+	"\x04span\x18\x01 \x01(\x01R\x04span\x12\x1f\n" +
+	"\vsteel_grade\x18\x02 \x01(\tR\n" +
+	"steelGrade\x12'\n" +
+	"\x03udl\x18\x03 \x01(\v2\x15.steelbeam.DesignLoadR\x03udl\x125\n" +
+	"\vpoint_loads\x18\x04 \x03(\v2\x14.steelbeam.PointLoadR\n" +
+	"pointLoads\x12\x17\n" +
+	"\agamma_g\x18\x05 \x01(\x01R\x06gammaG\x12\x17\n" +
+	"\agamma_q\x18\x06 \x01(\x01R\x06gammaQ\x121\n" +
+	"\x14continuous_restraint\x18\a \x01(\bR\x13continuousRestraint\x12/\n" +
+	"\x13restraint_positions\x18\b \x03(\x01R\x12restraintPositions\x12#\n" +
+	"\rload_position\x18\t \x01(\tR\floadPosition\x12)\n" +
+	"\x10deflection_limit\x18\n" +
+	" \x01(\x01R\x0fdeflectionLimit\x12,\n" +
+	"\x12ignore_self_weight\x18\v \x01(\bR\x10ignoreSelfWeight\x12\x1a\n" +
+	"\bsections\x18\f \x03(\tR\bsections\x12!\n" +
+	"\fonly_passing\x18\r \x01(\bR\vonlyPassing\x12\x14\n" +
+	"\x05limit\x18\x0e \x01(\x05R\x05limit\"r\n" +
+	"\vDesignCheck\x12!\n" +
+	"\fdesign_value\x18\x01 \x01(\x01R\vdesignValue\x12\x1e\n" +
+	"\n" +
+	"resistance\x18\x02 \x01(\x01R\n" +
+	"resistance\x12 \n" +
+	"\vutilisation\x18\x03 \x01(\x01R\vutilisation\"\xc9\x01\n" +
+	"\bLTBCheck\x12,\n" +
+	"\x05check\x18\x01 \x01(\v2\x16.steelbeam.DesignCheckR\x05check\x12#\n" +
+	"\rsegment_start\x18\x02 \x01(\x01R\fsegmentStart\x12\x1f\n" +
+	"\vsegment_end\x18\x03 \x01(\x01R\n" +
+	"segmentEnd\x12)\n" +
+	"\x10effective_length\x18\x04 \x01(\x01R\x0feffectiveLength\x12\x0e\n" +
+	"\x02c1\x18\x05 \x01(\x01R\x02c1\x12\x0e\n" +
+	"\x02c2\x18\x06 \x01(\x01R\x02c2\"\xbc\x04\n" +
+	"\x13SectionDesignResult\x12/\n" +
+	"\x13section_designation\x18\x01 \x01(\tR\x12sectionDesignation\x12$\n" +
+	"\x0emass_per_metre\x18\x02 \x01(\x01R\fmassPerMetre\x12#\n" +
+	"\rsection_class\x18\x03 \x01(\x05R\fsectionClass\x12\x16\n" +
+	"\x06passes\x18\x04 \x01(\bR\x06passes\x12\x1c\n" +
+	"\tgoverning\x18\x05 \x01(\tR\tgoverning\x12'\n" +
+	"\x0fmax_utilisation\x18\x06 \x01(\x01R\x0emaxUtilisation\x120\n" +
+	"\abending\x18\a \x01(\v2\x16.steelbeam.DesignCheckR\abending\x12,\n" +
+	"\x05shear\x18\b \x01(\v2\x16.steelbeam.DesignCheckR\x05shear\x12\x1d\n" +
+	"\n" +
+	"high_shear\x18\t \x01(\bR\thighShear\x12%\n" +
+	"\x03ltb\x18\n" +
+	" \x01(\v2\x13.steelbeam.LTBCheckR\x03ltb\x126\n" +
+	"\n" +
+	"deflection\x18\v \x01(\v2\x16.steelbeam.DesignCheckR\n" +
+	"deflection\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12-\n" +
+	"\x13shear_at_max_moment\x18\r \x01(\x01R\x10shearAtMaxMoment\x12'\n" +
+	"\x0fshear_reduction\x18\x0e \x01(\x01R\x0eshearReduction\"I\n" +
+	"\fStrengthBand\x12#\n" +
+	"\rmax_thickness\x18\x01 \x01(\x01R\fmaxThickness\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xa0\x03\n" +
+	"\bMaterial\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\tR\x05grade\x12\x1a\n" +
+	"\bstandard\x18\x02 \x01(\tR\bstandard\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12>\n" +
+	"\x0eyield_strength\x18\x04 \x03(\v2\x17.steelbeam.StrengthBandR\ryieldStrength\x12D\n" +
+	"\x11ultimate_strength\x18\x05 \x03(\v2\x17.steelbeam.StrengthBandR\x10ultimateStrength\x12'\n" +
+	"\x0felastic_modulus\x18\x06 \x01(\x01R\x0eelasticModulus\x12#\n" +
+	"\rshear_modulus\x18\a \x01(\x01R\fshearModulus\x12%\n" +
+	"\x0epoissons_ratio\x18\b \x01(\x01R\rpoissonsRatio\x12\x18\n" +
+	"\adensity\x18\t \x01(\x01R\adensity\x12+\n" +
+	"\x11thermal_expansion\x18\n" +
+	" \x01(\x01R\x10thermalExpansion\"\x9a\x01\n" +
+	"\x10MaterialStrength\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\tR\x05grade\x12\x1c\n" +
+	"\tthickness\x18\x02 \x01(\x01R\tthickness\x12%\n" +
+	"\x0eyield_strength\x18\x03 \x01(\x01R\ryieldStrength\x12+\n" +
+	"\x11ultimate_strength\x18\x04 \x01(\x01R\x10ultimateStrength\"\\\n" +
+	"\x13GetMaterialsRequest\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\tR\x05grade\x12!\n" +
+	"\tthickness\x18\x02 \x01(\x01H\x00R\tthickness\x88\x01\x01B\f\n" +
+	"\n" +
+	"_thickness\"\x84\x01\n" +
+	"\x14GetMaterialsResponse\x121\n" +
+	"\tmaterials\x18\x01 \x03(\v2\x13.steelbeam.MaterialR\tmaterials\x129\n" +
+	"\tstrengths\x18\x02 \x03(\v2\x1b.steelbeam.MaterialStrengthR\tstrengths2\x99\n" +
+	"\n" +
+	"\x10SteelBeamService\x12C\n" +
+	"\bGetBeams\x12\x1a.steelbeam.GetBeamsRequest\x1a\x1b.steelbeam.GetBeamsResponse\x12@\n" +
+	"\aGetBeam\x12\x19.steelbeam.GetBeamRequest\x1a\x1a.steelbeam.GetBeamResponse\x12I\n" +
+	"\n" +
+	"CreateBeam\x12\x1c.steelbeam.CreateBeamRequest\x1a\x1d.steelbeam.CreateBeamResponse\x12I\n" +
+	"\n" +
+	"UpdateBeam\x12\x1c.steelbeam.UpdateBeamRequest\x1a\x1d.steelbeam.UpdateBeamResponse\x12I\n" +
+	"\n" +
+	"DeleteBeam\x12\x1c.steelbeam.DeleteBeamRequest\x1a\x1d.steelbeam.DeleteBeamResponse\x12N\n" +
+	"\vImportBeams\x12\x1d.steelbeam.ImportBeamsRequest\x1a\x1e.steelbeam.ImportBeamsResponse(\x01\x12B\n" +
+	"\n" +
+	"WatchBeams\x12\x1c.steelbeam.WatchBeamsRequest\x1a\x14.steelbeam.BeamEvent0\x01\x12p\n" +
+	"\x17DeriveSectionProperties\x12).steelbeam.DeriveSectionPropertiesRequest\x1a*.steelbeam.DeriveSectionPropertiesResponse\x12I\n" +
+	"\n" +
+	"SelectBeam\x12\x1c.steelbeam.SelectBeamRequest\x1a\x1d.steelbeam.SelectBeamResponse\x12^\n" +
+	"\x17CalculateBeamResistance\x12 .steelbeam.BeamResistanceRequest\x1a!.steelbeam.BeamResistanceResponse\x12G\n" +
+	"\x16CalculateLTBResistance\x12\x15.steelbeam.LTBRequest\x1a\x16.steelbeam.LTBResponse\x12b\n" +
+	"\x15DesignSimplySupported\x12'.steelbeam.SimplySupportedDesignRequest\x1a\x1e.steelbeam.SectionDesignResult0\x01\x12O\n" +
+	"\fGetMaterials\x12\x1e.steelbeam.GetMaterialsRequest\x1a\x1f.steelbeam.GetMaterialsResponse\x12U\n" +
+	"\x0eGetStockStatus\x12 .steelbeam.GetStockStatusRequest\x1a!.steelbeam.GetStockStatusResponse\x12L\n" +
+	"\vGetSections\x12\x1d.steelbeam.GetSectionsRequest\x1a\x1e.steelbeam.GetSectionsResponse\x12I\n" +
+	"\n" +
+	"GetSection\x12\x1c.steelbeam.GetSectionRequest\x1a\x1d.steelbeam.GetSectionResponseB%Z#formandfunction-api/proto;steelbeamb\x06proto3"
+
+var (
+	file_steelbeam_proto_rawDescOnce sync.Once
+	file_steelbeam_proto_rawDescData []byte
+)
+
+func file_steelbeam_proto_rawDescGZIP() []byte {
+	file_steelbeam_proto_rawDescOnce.Do(func() {
+		file_steelbeam_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steelbeam_proto_rawDesc), len(file_steelbeam_proto_rawDesc)))
+	})
+	return file_steelbeam_proto_rawDescData
+}
+
+var file_steelbeam_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_steelbeam_proto_goTypes = []any{
+	(*SteelBeam)(nil),                       // 0: steelbeam.SteelBeam
+	(*BeamRangeFilter)(nil),                 // 1: steelbeam.BeamRangeFilter
+	(*GetBeamsRequest)(nil),                 // 2: steelbeam.GetBeamsRequest
+	(*GetBeamsResponse)(nil),                // 3: steelbeam.GetBeamsResponse
+	(*GetBeamRequest)(nil),                  // 4: steelbeam.GetBeamRequest
+	(*GetBeamResponse)(nil),                 // 5: steelbeam.GetBeamResponse
+	(*CreateBeamRequest)(nil),               // 6: steelbeam.CreateBeamRequest
+	(*CreateBeamResponse)(nil),              // 7: steelbeam.CreateBeamResponse
+	(*DeriveSectionPropertiesRequest)(nil),  // 8: steelbeam.DeriveSectionPropertiesRequest
+	(*DeriveSectionPropertiesResponse)(nil), // 9: steelbeam.DeriveSectionPropertiesResponse
+	(*ImportOptions)(nil),                   // 10: steelbeam.ImportOptions
+	(*ImportBeamsRequest)(nil),              // 11: steelbeam.ImportBeamsRequest
+	(*FieldError)(nil),                      // 12: steelbeam.FieldError
+	(*ImportRowResult)(nil),                 // 13: steelbeam.ImportRowResult
+	(*ImportBeamsResponse)(nil),             // 14: steelbeam.ImportBeamsResponse
+	(*UpdateBeamRequest)(nil),               // 15: steelbeam.UpdateBeamRequest
+	(*UpdateBeamResponse)(nil),              // 16: steelbeam.UpdateBeamResponse
+	(*DeleteBeamRequest)(nil),               // 17: steelbeam.DeleteBeamRequest
+	(*DeleteBeamResponse)(nil),              // 18: steelbeam.DeleteBeamResponse
+	(*WatchBeamsRequest)(nil),               // 19: steelbeam.WatchBeamsRequest
+	(*BeamEvent)(nil),                       // 20: steelbeam.BeamEvent
+	(*GetStockStatusRequest)(nil),           // 21: steelbeam.GetStockStatusRequest
+	(*BranchStock)(nil),                     // 22: steelbeam.BranchStock
+	(*SupplierStock)(nil),                   // 23: steelbeam.SupplierStock
+	(*GetStockStatusResponse)(nil),          // 24: steelbeam.GetStockStatusResponse
+	(*Section)(nil),                         // 25: steelbeam.Section
+	(*ISectionProperties)(nil),              // 26: steelbeam.ISectionProperties
+	(*ChannelProperties)(nil),               // 27: steelbeam.ChannelProperties
+	(*AngleProperties)(nil),                 // 28: steelbeam.AngleProperties
+	(*HollowSectionProperties)(nil),         // 29: steelbeam.HollowSectionProperties
+	(*TeeProperties)(nil),                   // 30: steelbeam.TeeProperties
+	(*GetSectionsRequest)(nil),              // 31: steelbeam.GetSectionsRequest
+	(*GetSectionsResponse)(nil),             // 32: steelbeam.GetSectionsResponse
+	(*GetSectionRequest)(nil),               // 33: steelbeam.GetSectionRequest
+	(*GetSectionResponse)(nil),              // 34: steelbeam.GetSectionResponse
+	(*SelectBeamRequest)(nil),               // 35: steelbeam.SelectBeamRequest
+	(*GoverningConstraint)(nil),             // 36: steelbeam.GoverningConstraint
+	(*BeamCandidate)(nil),                   // 37: steelbeam.BeamCandidate
+	(*SelectBeamResponse)(nil),              // 38: steelbeam.SelectBeamResponse
+	(*BeamResistanceRequest)(nil),           // 39: steelbeam.BeamResistanceRequest
+	(*BeamResistanceResponse)(nil),          // 40: steelbeam.BeamResistanceResponse
+	(*LTBRequest)(nil),                      // 41: steelbeam.LTBRequest
+	(*LTBResponse)(nil),                     // 42: steelbeam.LTBResponse
+	(*DesignLoad)(nil),                      // 43: steelbeam.DesignLoad
+	(*PointLoad)(nil),                       // 44: steelbeam.PointLoad
+	(*SimplySupportedDesignRequest)(nil),    // 45: steelbeam.SimplySupportedDesignRequest
+	(*DesignCheck)(nil),                     // 46: steelbeam.DesignCheck
+	(*LTBCheck)(nil),                        // 47: steelbeam.LTBCheck
+	(*SectionDesignResult)(nil),             // 48: steelbeam.SectionDesignResult
+	(*StrengthBand)(nil),                    // 49: steelbeam.StrengthBand
+	(*Material)(nil),                        // 50: steelbeam.Material
+	(*MaterialStrength)(nil),                // 51: steelbeam.MaterialStrength
+	(*GetMaterialsRequest)(nil),             // 52: steelbeam.GetMaterialsRequest
+	(*GetMaterialsResponse)(nil),            // 53: steelbeam.GetMaterialsResponse
+	nil,                                     // 54: steelbeam.SelectBeamRequest.MinimumsEntry
+	nil,                                     // 55: steelbeam.SelectBeamRequest.MaximumsEntry
+	(*timestamppb.Timestamp)(nil),           // 56: google.protobuf.Timestamp
+}
+var file_steelbeam_proto_depIdxs = []int32{
+	1,  // 0: steelbeam.GetBeamsRequest.filters:type_name -> steelbeam.BeamRangeFilter
+	0,  // 1: steelbeam.GetBeamsResponse.beams:type_name -> steelbeam.SteelBeam
+	0,  // 2: steelbeam.GetBeamResponse.beam:type_name -> steelbeam.SteelBeam
+	0,  // 3: steelbeam.CreateBeamRequest.beam:type_name -> steelbeam.SteelBeam
+	0,  // 4: steelbeam.CreateBeamResponse.beam:type_name -> steelbeam.SteelBeam
+	0,  // 5: steelbeam.DeriveSectionPropertiesResponse.beam:type_name -> steelbeam.SteelBeam
+	10, // 6: steelbeam.ImportBeamsRequest.options:type_name -> steelbeam.ImportOptions
+	0,  // 7: steelbeam.ImportBeamsRequest.beam:type_name -> steelbeam.SteelBeam
+	12, // 8: steelbeam.ImportRowResult.errors:type_name -> steelbeam.FieldError
+	13, // 9: steelbeam.ImportBeamsResponse.rows:type_name -> steelbeam.ImportRowResult
+	0,  // 10: steelbeam.UpdateBeamRequest.beam:type_name -> steelbeam.SteelBeam
+	0,  // 11: steelbeam.UpdateBeamResponse.beam:type_name -> steelbeam.SteelBeam
+	0,  // 12: steelbeam.BeamEvent.beam:type_name -> steelbeam.SteelBeam
+	56, // 13: steelbeam.BeamEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	22, // 14: steelbeam.SupplierStock.branches:type_name -> steelbeam.BranchStock
+	23, // 15: steelbeam.GetStockStatusResponse.suppliers:type_name -> steelbeam.SupplierStock
+	22, // 16: steelbeam.GetStockStatusResponse.branches:type_name -> steelbeam.BranchStock
+	26, // 17: steelbeam.Section.i_section:type_name -> steelbeam.ISectionProperties
+	27, // 18: steelbeam.Section.channel:type_name -> steelbeam.ChannelProperties
+	28, // 19: steelbeam.Section.angle:type_name -> steelbeam.AngleProperties
+	29, // 20: steelbeam.Section.hollow:type_name -> steelbeam.HollowSectionProperties
+	30, // 21: steelbeam.Section.tee:type_name -> steelbeam.TeeProperties
+	25, // 22: steelbeam.GetSectionsResponse.sections:type_name -> steelbeam.Section
+	25, // 23: steelbeam.GetSectionResponse.section:type_name -> steelbeam.Section
+	54, // 24: steelbeam.SelectBeamRequest.minimums:type_name -> steelbeam.SelectBeamRequest.MinimumsEntry
+	55, // 25: steelbeam.SelectBeamRequest.maximums:type_name -> steelbeam.SelectBeamRequest.MaximumsEntry
+	0,  // 26: steelbeam.BeamCandidate.beam:type_name -> steelbeam.SteelBeam
+	36, // 27: steelbeam.BeamCandidate.governing:type_name -> steelbeam.GoverningConstraint
+	37, // 28: steelbeam.SelectBeamResponse.candidates:type_name -> steelbeam.BeamCandidate
+	43, // 29: steelbeam.SimplySupportedDesignRequest.udl:type_name -> steelbeam.DesignLoad
+	44, // 30: steelbeam.SimplySupportedDesignRequest.point_loads:type_name -> steelbeam.PointLoad
+	46, // 31: steelbeam.LTBCheck.check:type_name -> steelbeam.DesignCheck
+	46, // 32: steelbeam.SectionDesignResult.bending:type_name -> steelbeam.DesignCheck
+	46, // 33: steelbeam.SectionDesignResult.shear:type_name -> steelbeam.DesignCheck
+	47, // 34: steelbeam.SectionDesignResult.ltb:type_name -> steelbeam.LTBCheck
+	46, // 35: steelbeam.SectionDesignResult.deflection:type_name -> steelbeam.DesignCheck
+	49, // 36: steelbeam.Material.yield_strength:type_name -> steelbeam.StrengthBand
+	49, // 37: steelbeam.Material.ultimate_strength:type_name -> steelbeam.StrengthBand
+	50, // 38: steelbeam.GetMaterialsResponse.materials:type_name -> steelbeam.Material
+	51, // 39: steelbeam.GetMaterialsResponse.strengths:type_name -> steelbeam.MaterialStrength
+	2,  // 40: steelbeam.SteelBeamService.GetBeams:input_type -> steelbeam.GetBeamsRequest
+	4,  // 41: steelbeam.SteelBeamService.GetBeam:input_type -> steelbeam.GetBeamRequest
+	6,  // 42: steelbeam.SteelBeamService.CreateBeam:input_type -> steelbeam.CreateBeamRequest
+	15, // 43: steelbeam.SteelBeamService.UpdateBeam:input_type -> steelbeam.UpdateBeamRequest
+	17, // 44: steelbeam.SteelBeamService.DeleteBeam:input_type -> steelbeam.DeleteBeamRequest
+	11, // 45: steelbeam.SteelBeamService.ImportBeams:input_type -> steelbeam.ImportBeamsRequest
+	19, // 46: steelbeam.SteelBeamService.WatchBeams:input_type -> steelbeam.WatchBeamsRequest
+	8,  // 47: steelbeam.SteelBeamService.DeriveSectionProperties:input_type -> steelbeam.DeriveSectionPropertiesRequest
+	35, // 48: steelbeam.SteelBeamService.SelectBeam:input_type -> steelbeam.SelectBeamRequest
+	39, // 49: steelbeam.SteelBeamService.CalculateBeamResistance:input_type -> steelbeam.BeamResistanceRequest
+	41, // 50: steelbeam.SteelBeamService.CalculateLTBResistance:input_type -> steelbeam.LTBRequest
+	45, // 51: steelbeam.SteelBeamService.DesignSimplySupported:input_type -> steelbeam.SimplySupportedDesignRequest
+	52, // 52: steelbeam.SteelBeamService.GetMaterials:input_type -> steelbeam.GetMaterialsRequest
+	21, // 53: steelbeam.SteelBeamService.GetStockStatus:input_type -> steelbeam.GetStockStatusRequest
+	31, // 54: steelbeam.SteelBeamService.GetSections:input_type -> steelbeam.GetSectionsRequest
+	33, // 55: steelbeam.SteelBeamService.GetSection:input_type -> steelbeam.GetSectionRequest
+	3,  // 56: steelbeam.SteelBeamService.GetBeams:output_type -> steelbeam.GetBeamsResponse
+	5,  // 57: steelbeam.SteelBeamService.GetBeam:output_type -> steelbeam.GetBeamResponse
+	7,  // 58: steelbeam.SteelBeamService.CreateBeam:output_type -> steelbeam.CreateBeamResponse
+	16, // 59: steelbeam.SteelBeamService.UpdateBeam:output_type -> steelbeam.UpdateBeamResponse
+	18, // 60: steelbeam.SteelBeamService.DeleteBeam:output_type -> steelbeam.DeleteBeamResponse
+	14, // 61: steelbeam.SteelBeamService.ImportBeams:output_type -> steelbeam.ImportBeamsResponse
+	20, // 62: steelbeam.SteelBeamService.WatchBeams:output_type -> steelbeam.BeamEvent
+	9,  // 63: steelbeam.SteelBeamService.DeriveSectionProperties:output_type -> steelbeam.DeriveSectionPropertiesResponse
+	38, // 64: steelbeam.SteelBeamService.SelectBeam:output_type -> steelbeam.SelectBeamResponse
+	40, // 65: steelbeam.SteelBeamService.CalculateBeamResistance:output_type -> steelbeam.BeamResistanceResponse
+	42, // 66: steelbeam.SteelBeamService.CalculateLTBResistance:output_type -> steelbeam.LTBResponse
+	48, // 67: steelbeam.SteelBeamService.DesignSimplySupported:output_type -> steelbeam.SectionDesignResult
+	53, // 68: steelbeam.SteelBeamService.GetMaterials:output_type -> steelbeam.GetMaterialsResponse
+	24, // 69: steelbeam.SteelBeamService.GetStockStatus:output_type -> steelbeam.GetStockStatusResponse
+	32, // 70: steelbeam.SteelBeamService.GetSections:output_type -> steelbeam.GetSectionsResponse
+	34, // 71: steelbeam.SteelBeamService.GetSection:output_type -> steelbeam.GetSectionResponse
+	56, // [56:72] is the sub-list for method output_type
+	40, // [40:56] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_steelbeam_proto_init() }
+func file_steelbeam_proto_init() {
+	if File_steelbeam_proto != nil {
+		return
+	}
+	file_steelbeam_proto_msgTypes[1].OneofWrappers = []any{}
+	file_steelbeam_proto_msgTypes[11].OneofWrappers = []any{
+		(*ImportBeamsRequest_Options)(nil),
+		(*ImportBeamsRequest_Beam)(nil),
+	}
+	file_steelbeam_proto_msgTypes[25].OneofWrappers = []any{
+		(*Section_ISection)(nil),
+		(*Section_Channel)(nil),
+		(*Section_Angle)(nil),
+		(*Section_Hollow)(nil),
+		(*Section_Tee)(nil),
 	}
+	file_steelbeam_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_steelbeam_proto_rawDesc), len(file_proto_steelbeam_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steelbeam_proto_rawDesc), len(file_steelbeam_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
-		GoTypes:           file_proto_steelbeam_proto_goTypes,
-		DependencyIndexes: file_proto_steelbeam_proto_depIdxs,
-		MessageInfos:      file_proto_steelbeam_proto_msgTypes,
+		GoTypes:           file_steelbeam_proto_goTypes,
+		DependencyIndexes: file_steelbeam_proto_depIdxs,
+		MessageInfos:      file_steelbeam_proto_msgTypes,
 	}.Build()
-	File_proto_steelbeam_proto = out.File
-	file_proto_steelbeam_proto_goTypes = nil
-	file_proto_steelbeam_proto_depIdxs = nil
+	File_steelbeam_proto = out.File
+	file_steelbeam_proto_goTypes = nil
+	file_steelbeam_proto_depIdxs = nil
 }
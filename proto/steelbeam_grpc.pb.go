@@ -2,7 +2,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.5.1
 // - protoc             v5.29.3
-// source: proto/steelbeam.proto
+// source: steelbeam.proto
 
 package steelbeam
 
@@ -19,10 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SteelBeamService_GetBeams_FullMethodName       = "/steelbeam.SteelBeamService/GetBeams"
-	SteelBeamService_GetBeam_FullMethodName        = "/steelbeam.SteelBeamService/GetBeam"
-	SteelBeamService_CreateBeam_FullMethodName     = "/steelbeam.SteelBeamService/CreateBeam"
-	SteelBeamService_GetStockStatus_FullMethodName = "/steelbeam.SteelBeamService/GetStockStatus"
+	SteelBeamService_GetBeams_FullMethodName                = "/steelbeam.SteelBeamService/GetBeams"
+	SteelBeamService_GetBeam_FullMethodName                 = "/steelbeam.SteelBeamService/GetBeam"
+	SteelBeamService_CreateBeam_FullMethodName              = "/steelbeam.SteelBeamService/CreateBeam"
+	SteelBeamService_UpdateBeam_FullMethodName              = "/steelbeam.SteelBeamService/UpdateBeam"
+	SteelBeamService_DeleteBeam_FullMethodName              = "/steelbeam.SteelBeamService/DeleteBeam"
+	SteelBeamService_ImportBeams_FullMethodName             = "/steelbeam.SteelBeamService/ImportBeams"
+	SteelBeamService_WatchBeams_FullMethodName              = "/steelbeam.SteelBeamService/WatchBeams"
+	SteelBeamService_DeriveSectionProperties_FullMethodName = "/steelbeam.SteelBeamService/DeriveSectionProperties"
+	SteelBeamService_SelectBeam_FullMethodName              = "/steelbeam.SteelBeamService/SelectBeam"
+	SteelBeamService_CalculateBeamResistance_FullMethodName = "/steelbeam.SteelBeamService/CalculateBeamResistance"
+	SteelBeamService_CalculateLTBResistance_FullMethodName  = "/steelbeam.SteelBeamService/CalculateLTBResistance"
+	SteelBeamService_DesignSimplySupported_FullMethodName   = "/steelbeam.SteelBeamService/DesignSimplySupported"
+	SteelBeamService_GetMaterials_FullMethodName            = "/steelbeam.SteelBeamService/GetMaterials"
+	SteelBeamService_GetStockStatus_FullMethodName          = "/steelbeam.SteelBeamService/GetStockStatus"
+	SteelBeamService_GetSections_FullMethodName             = "/steelbeam.SteelBeamService/GetSections"
+	SteelBeamService_GetSection_FullMethodName              = "/steelbeam.SteelBeamService/GetSection"
 )
 
 // SteelBeamServiceClient is the client API for SteelBeamService service.
@@ -37,8 +49,32 @@ type SteelBeamServiceClient interface {
 	GetBeam(ctx context.Context, in *GetBeamRequest, opts ...grpc.CallOption) (*GetBeamResponse, error)
 	// Create a new steel beam
 	CreateBeam(ctx context.Context, in *CreateBeamRequest, opts ...grpc.CallOption) (*CreateBeamResponse, error)
+	// Replace an existing steel beam
+	UpdateBeam(ctx context.Context, in *UpdateBeamRequest, opts ...grpc.CallOption) (*UpdateBeamResponse, error)
+	// Delete a steel beam
+	DeleteBeam(ctx context.Context, in *DeleteBeamRequest, opts ...grpc.CallOption) (*DeleteBeamResponse, error)
+	// Create or replace many beams, reporting the result of each
+	ImportBeams(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBeamsRequest, ImportBeamsResponse], error)
+	// Stream created, updated and deleted beam events
+	WatchBeams(ctx context.Context, in *WatchBeamsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BeamEvent], error)
+	// Compute area, second moments, moduli, torsion and warping constants from geometry
+	DeriveSectionProperties(ctx context.Context, in *DeriveSectionPropertiesRequest, opts ...grpc.CallOption) (*DeriveSectionPropertiesResponse, error)
+	// Select the lightest beams meeting minimum and maximum properties
+	SelectBeam(ctx context.Context, in *SelectBeamRequest, opts ...grpc.CallOption) (*SelectBeamResponse, error)
+	// Classify a beam and calculate Mc,Rd and Vc,Rd per EN 1993-1-1
+	CalculateBeamResistance(ctx context.Context, in *BeamResistanceRequest, opts ...grpc.CallOption) (*BeamResistanceResponse, error)
+	// Calculate Mcr and Mb,Rd for lateral-torsional buckling per EN 1993-1-1
+	CalculateLTBResistance(ctx context.Context, in *LTBRequest, opts ...grpc.CallOption) (*LTBResponse, error)
+	// Check candidate beams for a simply-supported span, streaming one result per section
+	DesignSimplySupported(ctx context.Context, in *SimplySupportedDesignRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SectionDesignResult], error)
+	// Get steel grades and their thickness-dependent strengths
+	GetMaterials(ctx context.Context, in *GetMaterialsRequest, opts ...grpc.CallOption) (*GetMaterialsResponse, error)
 	// Get stock status for a product
 	GetStockStatus(ctx context.Context, in *GetStockStatusRequest, opts ...grpc.CallOption) (*GetStockStatusResponse, error)
+	// Get all sections, optionally filtered by family
+	GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error)
+	// Get a specific section of any family by designation
+	GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error)
 }
 
 type steelBeamServiceClient struct {
@@ -79,6 +115,127 @@ func (c *steelBeamServiceClient) CreateBeam(ctx context.Context, in *CreateBeamR
 	return out, nil
 }
 
+func (c *steelBeamServiceClient) UpdateBeam(ctx context.Context, in *UpdateBeamRequest, opts ...grpc.CallOption) (*UpdateBeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBeamResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_UpdateBeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) DeleteBeam(ctx context.Context, in *DeleteBeamRequest, opts ...grpc.CallOption) (*DeleteBeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBeamResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_DeleteBeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) ImportBeams(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportBeamsRequest, ImportBeamsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SteelBeamService_ServiceDesc.Streams[0], SteelBeamService_ImportBeams_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportBeamsRequest, ImportBeamsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_ImportBeamsClient = grpc.ClientStreamingClient[ImportBeamsRequest, ImportBeamsResponse]
+
+func (c *steelBeamServiceClient) WatchBeams(ctx context.Context, in *WatchBeamsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BeamEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SteelBeamService_ServiceDesc.Streams[1], SteelBeamService_WatchBeams_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBeamsRequest, BeamEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_WatchBeamsClient = grpc.ServerStreamingClient[BeamEvent]
+
+func (c *steelBeamServiceClient) DeriveSectionProperties(ctx context.Context, in *DeriveSectionPropertiesRequest, opts ...grpc.CallOption) (*DeriveSectionPropertiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeriveSectionPropertiesResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_DeriveSectionProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) SelectBeam(ctx context.Context, in *SelectBeamRequest, opts ...grpc.CallOption) (*SelectBeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectBeamResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_SelectBeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) CalculateBeamResistance(ctx context.Context, in *BeamResistanceRequest, opts ...grpc.CallOption) (*BeamResistanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeamResistanceResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_CalculateBeamResistance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) CalculateLTBResistance(ctx context.Context, in *LTBRequest, opts ...grpc.CallOption) (*LTBResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LTBResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_CalculateLTBResistance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) DesignSimplySupported(ctx context.Context, in *SimplySupportedDesignRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SectionDesignResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SteelBeamService_ServiceDesc.Streams[2], SteelBeamService_DesignSimplySupported_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SimplySupportedDesignRequest, SectionDesignResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_DesignSimplySupportedClient = grpc.ServerStreamingClient[SectionDesignResult]
+
+func (c *steelBeamServiceClient) GetMaterials(ctx context.Context, in *GetMaterialsRequest, opts ...grpc.CallOption) (*GetMaterialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaterialsResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_GetMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *steelBeamServiceClient) GetStockStatus(ctx context.Context, in *GetStockStatusRequest, opts ...grpc.CallOption) (*GetStockStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStockStatusResponse)
@@ -89,6 +246,26 @@ func (c *steelBeamServiceClient) GetStockStatus(ctx context.Context, in *GetStoc
 	return out, nil
 }
 
+func (c *steelBeamServiceClient) GetSections(ctx context.Context, in *GetSectionsRequest, opts ...grpc.CallOption) (*GetSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSectionsResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_GetSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steelBeamServiceClient) GetSection(ctx context.Context, in *GetSectionRequest, opts ...grpc.CallOption) (*GetSectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSectionResponse)
+	err := c.cc.Invoke(ctx, SteelBeamService_GetSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SteelBeamServiceServer is the server API for SteelBeamService service.
 // All implementations must embed UnimplementedSteelBeamServiceServer
 // for forward compatibility.
@@ -101,8 +278,32 @@ type SteelBeamServiceServer interface {
 	GetBeam(context.Context, *GetBeamRequest) (*GetBeamResponse, error)
 	// Create a new steel beam
 	CreateBeam(context.Context, *CreateBeamRequest) (*CreateBeamResponse, error)
+	// Replace an existing steel beam
+	UpdateBeam(context.Context, *UpdateBeamRequest) (*UpdateBeamResponse, error)
+	// Delete a steel beam
+	DeleteBeam(context.Context, *DeleteBeamRequest) (*DeleteBeamResponse, error)
+	// Create or replace many beams, reporting the result of each
+	ImportBeams(grpc.ClientStreamingServer[ImportBeamsRequest, ImportBeamsResponse]) error
+	// Stream created, updated and deleted beam events
+	WatchBeams(*WatchBeamsRequest, grpc.ServerStreamingServer[BeamEvent]) error
+	// Compute area, second moments, moduli, torsion and warping constants from geometry
+	DeriveSectionProperties(context.Context, *DeriveSectionPropertiesRequest) (*DeriveSectionPropertiesResponse, error)
+	// Select the lightest beams meeting minimum and maximum properties
+	SelectBeam(context.Context, *SelectBeamRequest) (*SelectBeamResponse, error)
+	// Classify a beam and calculate Mc,Rd and Vc,Rd per EN 1993-1-1
+	CalculateBeamResistance(context.Context, *BeamResistanceRequest) (*BeamResistanceResponse, error)
+	// Calculate Mcr and Mb,Rd for lateral-torsional buckling per EN 1993-1-1
+	CalculateLTBResistance(context.Context, *LTBRequest) (*LTBResponse, error)
+	// Check candidate beams for a simply-supported span, streaming one result per section
+	DesignSimplySupported(*SimplySupportedDesignRequest, grpc.ServerStreamingServer[SectionDesignResult]) error
+	// Get steel grades and their thickness-dependent strengths
+	GetMaterials(context.Context, *GetMaterialsRequest) (*GetMaterialsResponse, error)
 	// Get stock status for a product
 	GetStockStatus(context.Context, *GetStockStatusRequest) (*GetStockStatusResponse, error)
+	// Get all sections, optionally filtered by family
+	GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error)
+	// Get a specific section of any family by designation
+	GetSection(context.Context, *GetSectionRequest) (*GetSectionResponse, error)
 	mustEmbedUnimplementedSteelBeamServiceServer()
 }
 
@@ -122,9 +323,45 @@ func (UnimplementedSteelBeamServiceServer) GetBeam(context.Context, *GetBeamRequ
 func (UnimplementedSteelBeamServiceServer) CreateBeam(context.Context, *CreateBeamRequest) (*CreateBeamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBeam not implemented")
 }
+func (UnimplementedSteelBeamServiceServer) UpdateBeam(context.Context, *UpdateBeamRequest) (*UpdateBeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBeam not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) DeleteBeam(context.Context, *DeleteBeamRequest) (*DeleteBeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBeam not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) ImportBeams(grpc.ClientStreamingServer[ImportBeamsRequest, ImportBeamsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBeams not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) WatchBeams(*WatchBeamsRequest, grpc.ServerStreamingServer[BeamEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBeams not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) DeriveSectionProperties(context.Context, *DeriveSectionPropertiesRequest) (*DeriveSectionPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeriveSectionProperties not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) SelectBeam(context.Context, *SelectBeamRequest) (*SelectBeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectBeam not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) CalculateBeamResistance(context.Context, *BeamResistanceRequest) (*BeamResistanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateBeamResistance not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) CalculateLTBResistance(context.Context, *LTBRequest) (*LTBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateLTBResistance not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) DesignSimplySupported(*SimplySupportedDesignRequest, grpc.ServerStreamingServer[SectionDesignResult]) error {
+	return status.Errorf(codes.Unimplemented, "method DesignSimplySupported not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) GetMaterials(context.Context, *GetMaterialsRequest) (*GetMaterialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterials not implemented")
+}
 func (UnimplementedSteelBeamServiceServer) GetStockStatus(context.Context, *GetStockStatusRequest) (*GetStockStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStockStatus not implemented")
 }
+func (UnimplementedSteelBeamServiceServer) GetSections(context.Context, *GetSectionsRequest) (*GetSectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSections not implemented")
+}
+func (UnimplementedSteelBeamServiceServer) GetSection(context.Context, *GetSectionRequest) (*GetSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSection not implemented")
+}
 func (UnimplementedSteelBeamServiceServer) mustEmbedUnimplementedSteelBeamServiceServer() {}
 func (UnimplementedSteelBeamServiceServer) testEmbeddedByValue()                          {}
 
@@ -200,6 +437,161 @@ func _SteelBeamService_CreateBeam_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SteelBeamService_UpdateBeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).UpdateBeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_UpdateBeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).UpdateBeam(ctx, req.(*UpdateBeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_DeleteBeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).DeleteBeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_DeleteBeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).DeleteBeam(ctx, req.(*DeleteBeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_ImportBeams_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SteelBeamServiceServer).ImportBeams(&grpc.GenericServerStream[ImportBeamsRequest, ImportBeamsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_ImportBeamsServer = grpc.ClientStreamingServer[ImportBeamsRequest, ImportBeamsResponse]
+
+func _SteelBeamService_WatchBeams_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBeamsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SteelBeamServiceServer).WatchBeams(m, &grpc.GenericServerStream[WatchBeamsRequest, BeamEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_WatchBeamsServer = grpc.ServerStreamingServer[BeamEvent]
+
+func _SteelBeamService_DeriveSectionProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveSectionPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).DeriveSectionProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_DeriveSectionProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).DeriveSectionProperties(ctx, req.(*DeriveSectionPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_SelectBeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectBeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).SelectBeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_SelectBeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).SelectBeam(ctx, req.(*SelectBeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_CalculateBeamResistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeamResistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).CalculateBeamResistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_CalculateBeamResistance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).CalculateBeamResistance(ctx, req.(*BeamResistanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_CalculateLTBResistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LTBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).CalculateLTBResistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_CalculateLTBResistance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).CalculateLTBResistance(ctx, req.(*LTBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_DesignSimplySupported_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SimplySupportedDesignRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SteelBeamServiceServer).DesignSimplySupported(m, &grpc.GenericServerStream[SimplySupportedDesignRequest, SectionDesignResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SteelBeamService_DesignSimplySupportedServer = grpc.ServerStreamingServer[SectionDesignResult]
+
+func _SteelBeamService_GetMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaterialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).GetMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_GetMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).GetMaterials(ctx, req.(*GetMaterialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SteelBeamService_GetStockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStockStatusRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +610,42 @@ func _SteelBeamService_GetStockStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SteelBeamService_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).GetSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_GetSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).GetSections(ctx, req.(*GetSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteelBeamService_GetSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteelBeamServiceServer).GetSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SteelBeamService_GetSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteelBeamServiceServer).GetSection(ctx, req.(*GetSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SteelBeamService_ServiceDesc is the grpc.ServiceDesc for SteelBeamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,11 +665,63 @@ var SteelBeamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBeam",
 			Handler:    _SteelBeamService_CreateBeam_Handler,
 		},
+		{
+			MethodName: "UpdateBeam",
+			Handler:    _SteelBeamService_UpdateBeam_Handler,
+		},
+		{
+			MethodName: "DeleteBeam",
+			Handler:    _SteelBeamService_DeleteBeam_Handler,
+		},
+		{
+			MethodName: "DeriveSectionProperties",
+			Handler:    _SteelBeamService_DeriveSectionProperties_Handler,
+		},
+		{
+			MethodName: "SelectBeam",
+			Handler:    _SteelBeamService_SelectBeam_Handler,
+		},
+		{
+			MethodName: "CalculateBeamResistance",
+			Handler:    _SteelBeamService_CalculateBeamResistance_Handler,
+		},
+		{
+			MethodName: "CalculateLTBResistance",
+			Handler:    _SteelBeamService_CalculateLTBResistance_Handler,
+		},
+		{
+			MethodName: "GetMaterials",
+			Handler:    _SteelBeamService_GetMaterials_Handler,
+		},
 		{
 			MethodName: "GetStockStatus",
 			Handler:    _SteelBeamService_GetStockStatus_Handler,
 		},
+		{
+			MethodName: "GetSections",
+			Handler:    _SteelBeamService_GetSections_Handler,
+		},
+		{
+			MethodName: "GetSection",
+			Handler:    _SteelBeamService_GetSection_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportBeams",
+			Handler:       _SteelBeamService_ImportBeams_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchBeams",
+			Handler:       _SteelBeamService_WatchBeams_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DesignSimplySupported",
+			Handler:       _SteelBeamService_DesignSimplySupported_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/steelbeam.proto",
+	Metadata: "steelbeam.proto",
 }
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrBeamNotFound is returned when no beam matches the requested section designation
+var ErrBeamNotFound = errors.New("beam not found")
+
+// ErrBeamExists is returned when a beam with the same section designation is already stored
+var ErrBeamExists = errors.New("beam already exists")
+
+// BeamRepository abstracts the storage of steel beams so the HTTP and gRPC
+// surfaces can share a single catalogue regardless of where it is persisted
+type BeamRepository interface {
+	// List returns every beam in the catalogue
+	List() ([]SteelBeam, error)
+	// Get returns the beam with the given section designation
+	Get(sectionDesignation string) (SteelBeam, error)
+	// Create adds a new beam to the catalogue, or returns ErrBeamExists
+	Create(beam SteelBeam) error
+	// Update replaces the beam with the given section designation. It
+	// returns ErrBeamExists if the replacement would duplicate another beam.
+	Update(sectionDesignation string, beam SteelBeam) error
+	// Delete removes the beam with the given section designation
+	Delete(sectionDesignation string) error
+}
+
+// NewBeamRepositoryFromEnv builds the repository selected by BEAM_STORE,
+// seeded with seed. Supported values are "memory" (default) and "file", the
+// latter persisting the catalogue as JSON at BEAM_STORE_PATH.
+func NewBeamRepositoryFromEnv(seed []SteelBeam) (BeamRepository, error) {
+	store, path, err := beamStoreFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if store == "file" {
+		return NewFileBeamRepository(path, seed)
+	}
+	return NewMemoryBeamRepository(seed), nil
+}
+
+// LoadBeamsFromEnv returns the catalogue held by the store selected by
+// BEAM_STORE without opening it for writing. A file store that does not
+// exist yet is reported as seed, which the server would create it from.
+func LoadBeamsFromEnv(seed []SteelBeam) ([]SteelBeam, error) {
+	store, path, err := beamStoreFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if store == "file" {
+		beams, err := readBeamsFile(path)
+		if !errors.Is(err, os.ErrNotExist) {
+			return beams, err
+		}
+	}
+	beams := make([]SteelBeam, len(seed))
+	copy(beams, seed)
+	return beams, nil
+}
+
+// beamStoreFromEnv returns the BEAM_STORE backend, "memory" or "file", and
+// the file store's path
+func beamStoreFromEnv() (string, string, error) {
+	store := os.Getenv("BEAM_STORE")
+	switch store {
+	case "", "memory":
+		return "memory", "", nil
+	case "file":
+		path := os.Getenv("BEAM_STORE_PATH")
+		if path == "" {
+			path = "beams.json"
+		}
+		return store, path, nil
+	default:
+		return "", "", fmt.Errorf("unknown BEAM_STORE %q (expected \"memory\" or \"file\")", store)
+	}
+}
+
+// memoryBeamRepository keeps beams in a slice for the lifetime of the
+// process. It is safe for concurrent use: readers share an RWMutex read lock
+// and every mutation builds a new slice (copy-on-write) under the write lock,
+// so a slice handed out by a previous read is never modified afterwards.
+type memoryBeamRepository struct {
+	mu    sync.RWMutex
+	beams []SteelBeam
+	// persist, when set, is called with the next catalogue before it is
+	// committed; an error aborts the mutation and leaves the catalogue as it was
+	persist func([]SteelBeam) error
+}
+
+// NewMemoryBeamRepository returns an in-memory repository seeded with a copy of seed
+func NewMemoryBeamRepository(seed []SteelBeam) BeamRepository {
+	return newMemoryBeamRepository(seed)
+}
+
+func newMemoryBeamRepository(seed []SteelBeam) *memoryBeamRepository {
+	beams := make([]SteelBeam, len(seed))
+	copy(beams, seed)
+	return &memoryBeamRepository{beams: beams}
+}
+
+func (r *memoryBeamRepository) List() ([]SteelBeam, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	beams := make([]SteelBeam, len(r.beams))
+	copy(beams, r.beams)
+	return beams, nil
+}
+
+func (r *memoryBeamRepository) Get(sectionDesignation string) (SteelBeam, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := indexOfBeam(r.beams, sectionDesignation)
+	if i < 0 {
+		return SteelBeam{}, ErrBeamNotFound
+	}
+	return r.beams[i], nil
+}
+
+func (r *memoryBeamRepository) Create(beam SteelBeam) error {
+	return r.mutate(func(current []SteelBeam) ([]SteelBeam, error) {
+		if indexOfBeam(current, beam.SectionDesignation) >= 0 {
+			return nil, ErrBeamExists
+		}
+		next := make([]SteelBeam, len(current), len(current)+1)
+		copy(next, current)
+		return append(next, beam), nil
+	})
+}
+
+func (r *memoryBeamRepository) Update(sectionDesignation string, beam SteelBeam) error {
+	return r.mutate(func(current []SteelBeam) ([]SteelBeam, error) {
+		i := indexOfBeam(current, sectionDesignation)
+		if i < 0 {
+			return nil, ErrBeamNotFound
+		}
+		if j := indexOfBeam(current, beam.SectionDesignation); j >= 0 && j != i {
+			return nil, ErrBeamExists
+		}
+		next := make([]SteelBeam, len(current))
+		copy(next, current)
+		next[i] = beam
+		return next, nil
+	})
+}
+
+func (r *memoryBeamRepository) Delete(sectionDesignation string) error {
+	return r.mutate(func(current []SteelBeam) ([]SteelBeam, error) {
+		i := indexOfBeam(current, sectionDesignation)
+		if i < 0 {
+			return nil, ErrBeamNotFound
+		}
+		next := make([]SteelBeam, 0, len(current)-1)
+		next = append(next, current[:i]...)
+		return append(next, current[i+1:]...), nil
+	})
+}
+
+// mutate applies change to the current catalogue under the write lock and
+// commits the result only if change and persist both succeed
+func (r *memoryBeamRepository) mutate(change func([]SteelBeam) ([]SteelBeam, error)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := change(r.beams)
+	if err != nil {
+		return err
+	}
+	if r.persist != nil {
+		if err := r.persist(next); err != nil {
+			return err
+		}
+	}
+	r.beams = next
+	return nil
+}
+
+func indexOfBeam(beams []SteelBeam, sectionDesignation string) int {
+	for i, beam := range beams {
+		if beam.SectionDesignation == sectionDesignation {
+			return i
+		}
+	}
+	return -1
+}
+
+// NewFileBeamRepository opens the JSON catalogue at path, creating it from
+// seed if the file does not exist yet. Beams are served from memory and the
+// whole catalogue is written back to the file after every mutation.
+func NewFileBeamRepository(path string, seed []SteelBeam) (BeamRepository, error) {
+	persist := func(beams []SteelBeam) error {
+		return writeBeamsFile(path, beams)
+	}
+
+	stored, err := readBeamsFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := persist(seed); err != nil {
+			return nil, err
+		}
+		r := newMemoryBeamRepository(seed)
+		r.persist = persist
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r := newMemoryBeamRepository(stored)
+	r.persist = persist
+	return r, nil
+}
+
+// readBeamsFile decodes the JSON catalogue at path. The error wraps
+// os.ErrNotExist if there is no file.
+func readBeamsFile(path string) ([]SteelBeam, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read beam store %s: %w", path, err)
+	}
+	var stored []SteelBeam
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to decode beam store %s: %w", path, err)
+	}
+	return stored, nil
+}
+
+// writeBeamsFile writes beams to a temporary file and renames it into place
+// so a crash mid-write never leaves a truncated store behind
+func writeBeamsFile(path string, beams []SteelBeam) error {
+	data, err := json.MarshalIndent(beams, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode beam store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write beam store %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write beam store %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write beam store %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write beam store %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+
+	pb "formandfunction-api/proto"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Run with go test -race: writers create, update and delete beams directly
+// and over HTTP and gRPC while readers list and fetch them from the same
+// repository.
+const (
+	repositoryWriters    = 4
+	repositoryReaders    = 4
+	repositoryIterations = 20
+)
+
+func TestMemoryRepositoryConcurrentAccess(t *testing.T) {
+	seed := testSeedBeams(t)
+	hammerRepository(t, NewMemoryBeamRepository(seed), len(seed))
+}
+
+func TestFileRepositoryConcurrentAccess(t *testing.T) {
+	seed := testSeedBeams(t)
+	path := filepath.Join(t.TempDir(), "beams.json")
+	repo, err := NewFileBeamRepository(path, seed)
+	if err != nil {
+		t.Fatalf("NewFileBeamRepository: %v", err)
+	}
+	want := hammerRepository(t, repo, len(seed))
+
+	// The file must hold exactly the final catalogue
+	reopened, err := NewFileBeamRepository(path, nil)
+	if err != nil {
+		t.Fatalf("reopening file store: %v", err)
+	}
+	beams, err := reopened.List()
+	if err != nil {
+		t.Fatalf("List after reopen: %v", err)
+	}
+	if got := beamDesignations(beams); !slices.Equal(got, want) {
+		t.Fatalf("reopened store has %d beams, want %d", len(got), len(want))
+	}
+}
+
+// The audit reads the file store without creating it
+func TestLoadBeamsFromEnvDoesNotWrite(t *testing.T) {
+	seed := testSeedBeams(t)
+	path := filepath.Join(t.TempDir(), "beams.json")
+	t.Setenv("BEAM_STORE", "file")
+	t.Setenv("BEAM_STORE_PATH", path)
+
+	beams, err := LoadBeamsFromEnv(seed)
+	if err != nil {
+		t.Fatalf("LoadBeamsFromEnv: %v", err)
+	}
+	if got, want := beamDesignations(beams), beamDesignations(seed); !slices.Equal(got, want) {
+		t.Fatalf("missing store read as %d beams, want the %d seed beams", len(got), len(want))
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("LoadBeamsFromEnv created the store: %v", err)
+	}
+
+	repo, err := NewFileBeamRepository(path, seed)
+	if err != nil {
+		t.Fatalf("NewFileBeamRepository: %v", err)
+	}
+	if err := repo.Delete(seed[0].SectionDesignation); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	beams, err = LoadBeamsFromEnv(seed)
+	if err != nil {
+		t.Fatalf("LoadBeamsFromEnv: %v", err)
+	}
+	if got, want := beamDesignations(beams), beamDesignations(seed[1:]); !slices.Equal(got, want) {
+		t.Fatalf("stored catalogue read as %d beams, want %d", len(got), len(want))
+	}
+}
+
+func testSeedBeams(t *testing.T) []SteelBeam {
+	t.Helper()
+	seed, err := LoadDefaultBeams()
+	if err != nil {
+		t.Fatalf("LoadDefaultBeams: %v", err)
+	}
+	return seed
+}
+
+// hammerRepository runs concurrent writers and readers against repo. Each
+// writer creates its own beams directly, over REST and over gRPC, updates
+// each one and deletes every other beam. It checks the catalogue holds
+// exactly the seed plus the beams that were kept, and returns the final
+// designations.
+func hammerRepository(t *testing.T, repo BeamRepository, seedCount int) []string {
+	t.Helper()
+	silenceLog(t)
+
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	service := NewBeamService(repo, events)
+	app := newTestHTTPApp(repo, service, events)
+	client := newTestGRPCClient(t, repo, service, events)
+	template := testSeedBeams(t)[0]
+	surfaces := map[string]func(beam SteelBeam, keep bool) error{
+		"RW": func(beam SteelBeam, keep bool) error { return repositoryMutations(repo, beam, keep) },
+		"HT": func(beam SteelBeam, keep bool) error { return httpMutations(app, beam, keep) },
+		"GR": func(beam SteelBeam, keep bool) error { return grpcMutations(client, beam, keep) },
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, len(surfaces)*repositoryWriters*repositoryIterations+repositoryReaders)
+	)
+	for prefix, mutations := range surfaces {
+		for w := 0; w < repositoryWriters; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < repositoryIterations; i++ {
+					beam := template
+					beam.SectionDesignation = fmt.Sprintf("%s%dx%d", prefix, w, i)
+					if err := mutations(beam, i%2 == 0); err != nil {
+						errs <- err
+					}
+				}
+			}()
+		}
+	}
+	// Readers make as many passes as a writer makes mutations; spinning
+	// until the writers finish would starve them under the race detector
+	for r := 0; r < repositoryReaders; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < len(surfaces)*repositoryIterations; i++ {
+				beams, err := repo.List()
+				if err != nil {
+					errs <- fmt.Errorf("list: %w", err)
+					return
+				}
+				if len(beams) < seedCount {
+					errs <- fmt.Errorf("list returned %d beams, fewer than the seed", len(beams))
+					return
+				}
+				if _, err := repo.Get(beams[len(beams)-1].SectionDesignation); err != nil && !errors.Is(err, ErrBeamNotFound) {
+					errs <- fmt.Errorf("get: %w", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	beams, err := repo.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	got := beamDesignations(beams)
+	kept := len(surfaces) * repositoryWriters * ((repositoryIterations + 1) / 2)
+	if len(got) != seedCount+kept {
+		t.Fatalf("catalogue has %d beams, want %d", len(got), seedCount+kept)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i] == got[i-1] {
+			t.Fatalf("duplicate beam %s", got[i])
+		}
+	}
+	for _, beam := range beams {
+		if _, ok := surfaces[beam.SectionDesignation[:2]]; ok && beam.MassPerMetre != template.MassPerMetre+1 {
+			t.Errorf("%s was not updated", beam.SectionDesignation)
+		}
+	}
+	return got
+}
+
+// repositoryMutations creates and updates beam in repo, then deletes it
+// unless keep is set
+func repositoryMutations(repo BeamRepository, beam SteelBeam, keep bool) error {
+	name := beam.SectionDesignation
+	if err := repo.Create(beam); err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	beam.MassPerMetre++
+	if err := repo.Update(name, beam); err != nil {
+		return fmt.Errorf("update %s: %w", name, err)
+	}
+	if keep {
+		return nil
+	}
+	if err := repo.Delete(name); err != nil {
+		return fmt.Errorf("delete %s: %w", name, err)
+	}
+	return nil
+}
+
+// newTestHTTPApp registers the beam routes used by the hammer
+func newTestHTTPApp(repo BeamRepository, service *BeamService, events *BeamEventLog) *fiber.App {
+	handlers := &httpHandlers{repo: repo, beams: service, events: events, closing: make(chan struct{})}
+	app := fiber.New()
+	app.Get("/beams", handlers.getBeams)
+	app.Post("/beams", handlers.createBeam)
+	app.Put("/beams/:sectionDesignation", handlers.updateBeam)
+	app.Delete("/beams/:sectionDesignation", handlers.deleteBeam)
+	return app
+}
+
+// newTestGRPCClient serves the legacy service over an in-memory listener
+func newTestGRPCClient(t *testing.T, repo BeamRepository, service *BeamService, events *BeamEventLog) pb.SteelBeamServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterSteelBeamServiceServer(srv, &server{repo: repo, beams: service, events: events})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewSteelBeamServiceClient(conn)
+}
+
+// httpMutations creates, updates and lists beam over REST, then deletes it
+// unless keep is set
+func httpMutations(app *fiber.App, beam SteelBeam, keep bool) error {
+	name := beam.SectionDesignation
+	if err := httpExpect(app, http.MethodPost, "/beams", beam, fiber.StatusCreated); err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	beam.MassPerMetre++
+	if err := httpExpect(app, http.MethodPut, "/beams/"+name, beam, fiber.StatusOK); err != nil {
+		return fmt.Errorf("update %s: %w", name, err)
+	}
+	if err := httpExpect(app, http.MethodGet, "/beams?limit=10", nil, fiber.StatusOK); err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+	if keep {
+		return nil
+	}
+	if err := httpExpect(app, http.MethodDelete, "/beams/"+name, nil, fiber.StatusNoContent); err != nil {
+		return fmt.Errorf("delete %s: %w", name, err)
+	}
+	return nil
+}
+
+func httpExpect(app *fiber.App, method, target string, body any, status int) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != status {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status %d, want %d: %s", resp.StatusCode, status, data)
+	}
+	return nil
+}
+
+// grpcMutations does what httpMutations does through the legacy service
+func grpcMutations(client pb.SteelBeamServiceClient, beam SteelBeam, keep bool) error {
+	ctx := context.Background()
+	name := beam.SectionDesignation
+	created, err := client.CreateBeam(ctx, &pb.CreateBeamRequest{Beam: steelBeamToProto(beam)})
+	if err != nil || !created.Success {
+		return fmt.Errorf("create %s: %v %v", name, err, created.GetMessage())
+	}
+	beam.MassPerMetre++
+	updated, err := client.UpdateBeam(ctx, &pb.UpdateBeamRequest{SectionDesignation: name, Beam: steelBeamToProto(beam)})
+	if err != nil || !updated.Success {
+		return fmt.Errorf("update %s: %v %v", name, err, updated.GetMessage())
+	}
+	if _, err := client.GetBeams(ctx, &pb.GetBeamsRequest{Limit: 10}); err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+	if keep {
+		return nil
+	}
+	deleted, err := client.DeleteBeam(ctx, &pb.DeleteBeamRequest{SectionDesignation: name})
+	if err != nil || !deleted.Success {
+		return fmt.Errorf("delete %s: %v %v", name, err, deleted.GetMessage())
+	}
+	return nil
+}
+
+func beamDesignations(beams []SteelBeam) []string {
+	names := make([]string, len(beams))
+	for i, beam := range beams {
+		names[i] = beam.SectionDesignation
+	}
+	sort.Strings(names)
+	return names
+}
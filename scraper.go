@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,6 +10,9 @@ import (
 
 const graphQLURL = "https://www.travisperkins.co.uk/graphql?op=tpplcProductCollectionAvailability"
 
+// travisPerkinsBrandID selects the Travis Perkins brand on the group's GraphQL API
+const travisPerkinsBrandID = "tp"
+
 // GraphQLRequest represents the payload for the GraphQL request.
 type GraphQLRequest struct {
 	OperationName string    `json:"operationName"`
@@ -18,9 +22,12 @@ type GraphQLRequest struct {
 
 // Variables represents the variables for the GraphQL query.
 type Variables struct {
-	ProductID string `json:"productId"`
-	Postcode  string `json:"postcode"`
-	BrandID   string `json:"brandId"`
+	ProductID    string  `json:"productId"`
+	Postcode     string  `json:"postcode"`
+	BrandID      string  `json:"brandId"`
+	BranchID     string  `json:"branchId,omitempty"`
+	BranchLimit  int     `json:"branchLimit,omitempty"`
+	WithinRadius float64 `json:"withinRadius,omitempty"`
 }
 
 // GraphQLResponse represents the expected response from the GraphQL API.
@@ -30,13 +37,34 @@ type GraphQLResponse struct {
 			ProductCollectionAvailability []struct {
 				BranchID   string  `json:"branchId"`
 				StockLevel float64 `json:"stockLevel"`
+				StockUOM   string  `json:"stockUom"`
 			} `json:"productCollectionAvailability"`
 		} `json:"tpplcBrand"`
 	} `json:"data"`
 }
 
-// GetStockStatus sends a request to the GraphQL API to get stock info.
-func GetStockStatus(productID, postcode string) (string, error) {
+// TravisPerkinsProvider is the StockProvider for Travis Perkins, queried
+// through the collection availability operation of its GraphQL API
+type TravisPerkinsProvider struct {
+	URL     string
+	BrandID string
+	Client  *SupplierClient
+}
+
+// NewTravisPerkinsProvider returns a provider for the public Travis Perkins
+// API that sends its requests through client
+func NewTravisPerkinsProvider(client *SupplierClient) *TravisPerkinsProvider {
+	return &TravisPerkinsProvider{URL: graphQLURL, BrandID: travisPerkinsBrandID, Client: client}
+}
+
+// Name identifies the provider in the registry and in stock responses
+func (p *TravisPerkinsProvider) Name() string {
+	return "travis_perkins"
+}
+
+// Availability sends a request to the GraphQL API to get stock info for
+// each branch near the postcode.
+func (p *TravisPerkinsProvider) Availability(ctx context.Context, query StockQuery) ([]BranchStock, error) {
 	requestBody := GraphQLRequest{
 		OperationName: "tpplcProductCollectionAvailability",
 		Query: `query tpplcProductCollectionAvailability($branchId: String, $branchLimit: Int, $postcode: String, $productId: String!, $withinRadius: Float, $brandId: ID!) {\n  tpplcBrand(brandId: $brandId) {\n    productCollectionAvailability(\n      branchId: $branchId
@@ -53,49 +81,47 @@ func GetStockStatus(productID, postcode string) (string, error) {
   }
 }`,
 		Variables: Variables{
-			ProductID: productID,
-			Postcode:  postcode,
-			BrandID:   "tp",
+			ProductID:    query.ProductID,
+			Postcode:     query.Postcode,
+			BrandID:      p.BrandID,
+			BranchID:     query.BranchID,
+			BranchLimit:  query.BranchLimit,
+			WithinRadius: query.WithinRadius,
 		},
 	}
 
 	jsonBody, err := json.Marshal(requestBody)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal graphql request: %w", err)
+		return nil, fmt.Errorf("failed to marshal graphql request: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", graphQLURL, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", p.URL, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return "", fmt.Errorf("failed to create http request: %w", err)
+		return nil, fmt.Errorf("failed to create http request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.110 Safari/537.36")
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := p.Client.Do(p.Name(), req)
 	if err != nil {
-		return "", fmt.Errorf("failed to send request to graphql api: %w", err)
+		return nil, fmt.Errorf("failed to send request to graphql api: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("graphql api request failed with status: %s", resp.Status)
+		return nil, fmt.Errorf("graphql api request failed with status: %s", resp.Status)
 	}
 
 	var gqlResponse GraphQLResponse
 	if err := json.NewDecoder(resp.Body).Decode(&gqlResponse); err != nil {
-		return "", fmt.Errorf("failed to decode graphql response: %w", err)
+		return nil, fmt.Errorf("failed to decode graphql response: %w", err)
 	}
 
-	if len(gqlResponse.Data.TpplcBrand.ProductCollectionAvailability) > 0 {
-		for _, branch := range gqlResponse.Data.TpplcBrand.ProductCollectionAvailability {
-			if branch.StockLevel > 0 {
-				return "InStock", nil
-			}
-		}
-		return "OutOfStock", nil
+	availability := gqlResponse.Data.TpplcBrand.ProductCollectionAvailability
+	branches := make([]BranchStock, len(availability))
+	for i, branch := range availability {
+		branches[i] = BranchStock{BranchID: branch.BranchID, StockLevel: branch.StockLevel, StockUOM: branch.StockUOM}
 	}
-
-	return "NotAvailable", nil
+	return branches, nil
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The merchant's per-branch stock is returned field for field
+func TestTravisPerkinsProviderBranchDetail(t *testing.T) {
+	silenceLog(t)
+	want := []BranchStock{
+		{BranchID: "1001", StockLevel: 12.5, StockUOM: "M"},
+		{BranchID: "1002", StockLevel: 0, StockUOM: "M"},
+		{BranchID: "1003", StockLevel: 4, StockUOM: "EA"},
+	}
+	provider := newFakeMerchant(t, map[string][]BranchStock{"P1": want}).provider()
+
+	got, err := provider.Availability(context.Background(), StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("branches %+v, want %+v", got, want)
+	}
+
+	got, err = provider.Availability(context.Background(), StockQuery{ProductID: "P2", Postcode: "SW1A 1AA"})
+	if err != nil || got == nil || len(got) != 0 {
+		t.Errorf("unlisted product gave %+v, %v; want no branches", got, err)
+	}
+}
+
+func TestTravisPerkinsProviderReportsStatus(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, nil)
+	merchant.set(func(m *fakeMerchant) { m.status = 404 })
+	_, err := merchant.provider().Availability(context.Background(), StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"})
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("got %v, want the merchant's status", err)
+	}
+}
+
+// Query filters reach the merchant as GraphQL variables; unset ones are
+// left out so the merchant applies its defaults
+func TestTravisPerkinsProviderSendsVariables(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, nil)
+	provider := merchant.provider()
+	queries := []StockQuery{
+		{ProductID: "P1", Postcode: "SW1A 1AA"},
+		{ProductID: "P2", Postcode: "M1 1AE", BranchID: "1001", BranchLimit: 5, WithinRadius: 12.5},
+	}
+	for _, query := range queries {
+		if _, err := provider.Availability(context.Background(), query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []map[string]any{
+		{"productId": "P1", "postcode": "SW1A 1AA", "brandId": travisPerkinsBrandID},
+		{"productId": "P2", "postcode": "M1 1AE", "brandId": travisPerkinsBrandID,
+			"branchId": "1001", "branchLimit": 5.0, "withinRadius": 12.5},
+	}
+	if got := merchant.variables(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("variables:\n got %v\nwant %v", got, want)
+	}
+}
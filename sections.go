@@ -0,0 +1,340 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// SectionFamily identifies a family of steel sections, e.g. "UB" or "CHS"
+type SectionFamily string
+
+const (
+	FamilyUniversalBeam     SectionFamily = "UB"
+	FamilyUniversalColumn   SectionFamily = "UC"
+	FamilyParallelChannel   SectionFamily = "PFC"
+	FamilyEqualAngle        SectionFamily = "EA"
+	FamilyUnequalAngle      SectionFamily = "UA"
+	FamilyCircularHollow    SectionFamily = "CHS"
+	FamilyRectangularHollow SectionFamily = "RHS"
+	FamilySquareHollow      SectionFamily = "SHS"
+	FamilyTeeFromBeam       SectionFamily = "UBT"
+)
+
+// SectionFamilyInfo describes a section family and which property block its sections carry
+type SectionFamilyInfo struct {
+	Family      SectionFamily `json:"family"`
+	Name        string        `json:"name"`
+	Properties  string        `json:"properties"`
+	Description string        `json:"description"`
+}
+
+// sectionFamilies lists every supported family in display order
+var sectionFamilies = []SectionFamilyInfo{
+	{FamilyUniversalBeam, "Universal beams", "i_section", "UKB I-sections, served from the beam catalogue"},
+	{FamilyUniversalColumn, "Universal columns", "i_section", "UKC H-sections"},
+	{FamilyParallelChannel, "Parallel flange channels", "channel", "UKPFC channels"},
+	{FamilyEqualAngle, "Equal angles", "angle", "UKA equal leg angles"},
+	{FamilyUnequalAngle, "Unequal angles", "angle", "UKA unequal leg angles"},
+	{FamilyCircularHollow, "Circular hollow sections", "hollow", "Hot-finished CHS to EN 10210"},
+	{FamilyRectangularHollow, "Rectangular hollow sections", "hollow", "Hot-finished RHS to EN 10210"},
+	{FamilySquareHollow, "Square hollow sections", "hollow", "Hot-finished SHS to EN 10210"},
+	{FamilyTeeFromBeam, "Tees split from universal beams", "tee", "UKB halved through the web"},
+}
+
+// ParseSectionFamily validates a family code, ignoring case
+func ParseSectionFamily(code string) (SectionFamily, error) {
+	for _, info := range sectionFamilies {
+		if strings.EqualFold(string(info.Family), code) {
+			return info.Family, nil
+		}
+	}
+	codes := make([]string, len(sectionFamilies))
+	for i, info := range sectionFamilies {
+		codes[i] = string(info.Family)
+	}
+	return "", fmt.Errorf("unknown section family %q (expected one of %s)", code, strings.Join(codes, ", "))
+}
+
+// Section is a steel section of any family. The common properties use the
+// same Blue Book units as SteelBeam; exactly one family-specific block is set.
+type Section struct {
+	SectionDesignation      string        `json:"section_designation"`
+	Family                  SectionFamily `json:"family"`
+	MassPerMetre            float64       `json:"mass_per_metre"`
+	AreaOfSection           float64       `json:"area_of_section"`
+	SurfaceAreaPerMetre     float64       `json:"surface_area_per_metre"`
+	SecondMomentOfAreaAxisY float64       `json:"second_moment_of_area_axis_y"`
+	SecondMomentOfAreaAxisZ float64       `json:"second_moment_of_area_axis_z"`
+	RadiusOfGyrationAxisY   float64       `json:"radius_of_gyration_axis_y"`
+	RadiusOfGyrationAxisZ   float64       `json:"radius_of_gyration_axis_z"`
+	ElasticModulusAxisY     float64       `json:"elastic_modulus_axis_y"`
+	ElasticModulusAxisZ     float64       `json:"elastic_modulus_axis_z"`
+	PlasticModulusAxisY     float64       `json:"plastic_modulus_axis_y"`
+	PlasticModulusAxisZ     float64       `json:"plastic_modulus_axis_z"`
+	TorsionalConstant       float64       `json:"torsional_constant"`
+
+	ISection *ISectionProperties      `json:"i_section,omitempty"`
+	Channel  *ChannelProperties       `json:"channel,omitempty"`
+	Angle    *AngleProperties         `json:"angle,omitempty"`
+	Hollow   *HollowSectionProperties `json:"hollow,omitempty"`
+	Tee      *TeeProperties           `json:"tee,omitempty"`
+}
+
+// ISectionProperties holds the dimensions and torsional properties of UB and UC sections
+type ISectionProperties struct {
+	DepthOfSection      float64 `json:"depth_of_section"`
+	WidthOfSection      float64 `json:"width_of_section"`
+	ThicknessWeb        float64 `json:"thickness_web"`
+	ThicknessFlange     float64 `json:"thickness_flange"`
+	RootRadius          float64 `json:"root_radius"`
+	DepthBetweenFillets float64 `json:"depth_between_fillets"`
+	BucklingParameter   float64 `json:"buckling_parameter"`
+	TorsionalIndex      float64 `json:"torsional_index"`
+	WarpingConstant     float64 `json:"warping_constant"`
+}
+
+// ChannelProperties holds the dimensions of parallel flange channels.
+// CentroidDistance is measured from the back of the web and
+// ShearCentreFromCentroid from the centroid, both in cm.
+type ChannelProperties struct {
+	DepthOfSection          float64 `json:"depth_of_section"`
+	WidthOfSection          float64 `json:"width_of_section"`
+	ThicknessWeb            float64 `json:"thickness_web"`
+	ThicknessFlange         float64 `json:"thickness_flange"`
+	RootRadius              float64 `json:"root_radius"`
+	DepthBetweenFillets     float64 `json:"depth_between_fillets"`
+	CentroidDistance        float64 `json:"centroid_distance"`
+	ShearCentreFromCentroid float64 `json:"shear_centre_from_centroid"`
+	WarpingConstant         float64 `json:"warping_constant"`
+}
+
+// AngleProperties holds the leg dimensions and principal axis properties of
+// angles. The long leg lies along z; CentroidDistanceY is measured from the
+// back of the long leg and CentroidDistanceZ from the back of the short leg.
+type AngleProperties struct {
+	LegLengthLong           float64 `json:"leg_length_long"`
+	LegLengthShort          float64 `json:"leg_length_short"`
+	Thickness               float64 `json:"thickness"`
+	RootRadius              float64 `json:"root_radius"`
+	ToeRadius               float64 `json:"toe_radius"`
+	CentroidDistanceY       float64 `json:"centroid_distance_y"`
+	CentroidDistanceZ       float64 `json:"centroid_distance_z"`
+	SecondMomentOfAreaAxisU float64 `json:"second_moment_of_area_axis_u"`
+	SecondMomentOfAreaAxisV float64 `json:"second_moment_of_area_axis_v"`
+	RadiusOfGyrationAxisU   float64 `json:"radius_of_gyration_axis_u"`
+	RadiusOfGyrationAxisV   float64 `json:"radius_of_gyration_axis_v"`
+	TanAlpha                float64 `json:"tan_alpha"`
+}
+
+// HollowSectionProperties holds the dimensions of CHS, RHS and SHS.
+// OutsideDiameter is only set for CHS; depth and width only for RHS/SHS.
+type HollowSectionProperties struct {
+	OutsideDiameter  float64 `json:"outside_diameter,omitempty"`
+	DepthOfSection   float64 `json:"depth_of_section,omitempty"`
+	WidthOfSection   float64 `json:"width_of_section,omitempty"`
+	WallThickness    float64 `json:"wall_thickness"`
+	TorsionalModulus float64 `json:"torsional_modulus"`
+}
+
+// TeeProperties holds the dimensions of tees. CentroidDistance is measured
+// from the outer face of the flange, in cm.
+type TeeProperties struct {
+	DepthOfSection   float64 `json:"depth_of_section"`
+	WidthOfSection   float64 `json:"width_of_section"`
+	ThicknessWeb     float64 `json:"thickness_web"`
+	ThicknessFlange  float64 `json:"thickness_flange"`
+	RootRadius       float64 `json:"root_radius"`
+	CentroidDistance float64 `json:"centroid_distance"`
+}
+
+// propertyBlock returns the name of the family-specific block that is set, or
+// an error if there is not exactly one
+func (s Section) propertyBlock() (string, error) {
+	var set []string
+	if s.ISection != nil {
+		set = append(set, "i_section")
+	}
+	if s.Channel != nil {
+		set = append(set, "channel")
+	}
+	if s.Angle != nil {
+		set = append(set, "angle")
+	}
+	if s.Hollow != nil {
+		set = append(set, "hollow")
+	}
+	if s.Tee != nil {
+		set = append(set, "tee")
+	}
+	if len(set) != 1 {
+		return "", fmt.Errorf("expected exactly one property block, found %d", len(set))
+	}
+	return set[0], nil
+}
+
+// SectionFromBeam converts a universal beam into a Section
+func SectionFromBeam(beam SteelBeam) Section {
+	return Section{
+		SectionDesignation:      beam.SectionDesignation,
+		Family:                  FamilyUniversalBeam,
+		MassPerMetre:            beam.MassPerMetre,
+		AreaOfSection:           beam.AreaOfSection,
+		SurfaceAreaPerMetre:     beam.SurfaceAreaPerMetre,
+		SecondMomentOfAreaAxisY: beam.SecondMomentOfAreaAxisY,
+		SecondMomentOfAreaAxisZ: beam.SecondMomentOfAreaAxisZ,
+		RadiusOfGyrationAxisY:   beam.RadiusOfGyrationAxisY,
+		RadiusOfGyrationAxisZ:   beam.RadiusOfGyrationAxisZ,
+		ElasticModulusAxisY:     beam.ElasticModulusAxisY,
+		ElasticModulusAxisZ:     beam.ElasticModulusAxisZ,
+		PlasticModulusAxisY:     beam.PlasticModulusAxisY,
+		PlasticModulusAxisZ:     beam.PlasticModulusAxisZ,
+		TorsionalConstant:       beam.TorsionalConstant,
+		ISection: &ISectionProperties{
+			DepthOfSection:      beam.DepthOfSection,
+			WidthOfSection:      beam.WidthOfSection,
+			ThicknessWeb:        beam.ThicknessWeb,
+			ThicknessFlange:     beam.ThicknessFlange,
+			RootRadius:          beam.RootRadius,
+			DepthBetweenFillets: beam.DepthBetweenFillets,
+			BucklingParameter:   beam.BucklingParameter,
+			TorsionalIndex:      beam.TorsionalIndex,
+			WarpingConstant:     beam.WarpingConstant,
+		},
+	}
+}
+
+// ErrSectionNotFound is returned when no section matches a designation
+var ErrSectionNotFound = errors.New("section not found")
+
+// SectionCatalogue serves sections of every family. Universal beams come
+// from the live beam repository; the other families are read-only tables.
+type SectionCatalogue struct {
+	beams  BeamRepository
+	tables map[SectionFamily][]Section
+}
+
+// NewSectionCatalogue combines the beam repository with the built-in tables
+func NewSectionCatalogue(beams BeamRepository, tables map[SectionFamily][]Section) *SectionCatalogue {
+	return &SectionCatalogue{beams: beams, tables: tables}
+}
+
+// List returns every section of the given family, or of all families if family is empty
+func (c *SectionCatalogue) List(family SectionFamily) ([]Section, error) {
+	var sections []Section
+	for _, info := range sectionFamilies {
+		if family != "" && info.Family != family {
+			continue
+		}
+		if info.Family == FamilyUniversalBeam {
+			beams, err := c.beams.List()
+			if err != nil {
+				return nil, err
+			}
+			for _, beam := range beams {
+				sections = append(sections, SectionFromBeam(beam))
+			}
+			continue
+		}
+		sections = append(sections, c.tables[info.Family]...)
+	}
+	return sections, nil
+}
+
+// Get returns the section with the given designation from any family
+func (c *SectionCatalogue) Get(sectionDesignation string) (Section, error) {
+	beam, err := c.beams.Get(sectionDesignation)
+	if err == nil {
+		return SectionFromBeam(beam), nil
+	}
+	if !errors.Is(err, ErrBeamNotFound) {
+		return Section{}, err
+	}
+	for _, info := range sectionFamilies {
+		for _, section := range c.tables[info.Family] {
+			if section.SectionDesignation == sectionDesignation {
+				return section, nil
+			}
+		}
+	}
+	return Section{}, ErrSectionNotFound
+}
+
+// LoadSectionTables parses the embedded JSON tables for every family other
+// than universal beams. Each file must contain one family only, every section
+// must carry that family's property block, and designations must be unique.
+func LoadSectionTables() (map[SectionFamily][]Section, error) {
+	files, err := fs.Glob(sectionTables, "data/sections/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tables = map[SectionFamily][]Section{}
+		errs   []error
+		seen   = map[string]string{}
+	)
+	for _, name := range files {
+		data, err := sectionTables.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read section table %s: %w", name, err)
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		var sections []Section
+		if err := decoder.Decode(&sections); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		for i, section := range sections {
+			location := fmt.Sprintf("%s[%d]", name, i)
+			if err := checkTableSection(section); err != nil {
+				errs = append(errs, fmt.Errorf("%s (%s): %w", location, section.SectionDesignation, err))
+				continue
+			}
+			if first, ok := seen[section.SectionDesignation]; ok {
+				errs = append(errs, fmt.Errorf("%s (%s): duplicate section designation (first defined at %s)", location, section.SectionDesignation, first))
+				continue
+			}
+			seen[section.SectionDesignation] = location
+			tables[section.Family] = append(tables[section.Family], section)
+		}
+		if len(sections) > 0 && path.Base(name) != strings.ToLower(string(sections[0].Family))+".json" {
+			errs = append(errs, fmt.Errorf("%s: file name does not match family %s", name, sections[0].Family))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return tables, nil
+}
+
+// checkTableSection verifies a built-in section has a known family and the matching property block
+func checkTableSection(section Section) error {
+	if section.SectionDesignation == "" {
+		return errors.New("section_designation is empty")
+	}
+	family, err := ParseSectionFamily(string(section.Family))
+	if err != nil {
+		return err
+	}
+	if family == FamilyUniversalBeam {
+		return errors.New("universal beams belong in the beam tables")
+	}
+	block, err := section.propertyBlock()
+	if err != nil {
+		return err
+	}
+	for _, info := range sectionFamilies {
+		if info.Family == family && info.Properties != block {
+			return fmt.Errorf("family %s expects %s properties, found %s", family, info.Properties, block)
+		}
+	}
+	return nil
+}
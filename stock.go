@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stock statuses reported by providers
+const (
+	StockInStock      = "InStock"
+	StockOutOfStock   = "OutOfStock"
+	StockNotAvailable = "NotAvailable"
+)
+
+// stockProviderTimeout bounds each provider's request when fanning out, so
+// one slow merchant cannot hold up the others' results
+const stockProviderTimeout = 15 * time.Second
+
+// AllStockProviders is the supplier value that queries every provider
+const AllStockProviders = "all"
+
+// ErrUnknownStockProvider is returned when no provider has the requested name
+var ErrUnknownStockProvider = errors.New("unknown stock provider")
+
+// StockQuery identifies the product and location a provider is asked about.
+// ProductID is the merchant's own product code. The optional fields narrow
+// the branches searched; zero values leave the merchant's defaults.
+type StockQuery struct {
+	ProductID string
+	Postcode  string
+	// BranchID asks about a single branch
+	BranchID string
+	// BranchLimit caps the number of branches returned, nearest first
+	BranchLimit int
+	// WithinRadius limits branches to this many miles from the postcode
+	WithinRadius float64
+}
+
+// Validate reports a negative branch limit or an invalid radius
+func (q StockQuery) Validate() error {
+	if q.BranchLimit < 0 {
+		return errors.New("branchLimit must be a non-negative integer")
+	}
+	if q.WithinRadius < 0 || math.IsNaN(q.WithinRadius) || math.IsInf(q.WithinRadius, 0) {
+		return errors.New("withinRadius must be a non-negative number of miles")
+	}
+	return nil
+}
+
+// BranchStock is the stock of a product held at one merchant branch.
+// StockUOM is the merchant's unit for StockLevel, e.g. "EA" or "M".
+type BranchStock struct {
+	BranchID   string  `json:"branchId"`
+	StockLevel float64 `json:"stockLevel"`
+	StockUOM   string  `json:"stockUom"`
+}
+
+// StockProvider reports product availability at one merchant
+type StockProvider interface {
+	// Name identifies the merchant, e.g. "travis_perkins"
+	Name() string
+	// Availability returns the merchant's branches near the query postcode
+	// that list the product, with their stock. It returns no branches if
+	// the merchant does not sell the product there.
+	Availability(ctx context.Context, query StockQuery) ([]BranchStock, error)
+}
+
+// BranchStockStatus summarises branch stock: InStock if any branch holds
+// stock, OutOfStock if branches list the product but none hold it, and
+// NotAvailable if no branch lists it
+func BranchStockStatus(branches []BranchStock) string {
+	if len(branches) == 0 {
+		return StockNotAvailable
+	}
+	for _, branch := range branches {
+		if branch.StockLevel > 0 {
+			return StockInStock
+		}
+	}
+	return StockOutOfStock
+}
+
+// SupplierStock is one provider's answer. Status is derived from Branches.
+// Error is set instead of Status when the provider could not be reached.
+// Cache reports whether the answer came from the stock cache.
+type SupplierStock struct {
+	Supplier string        `json:"supplier"`
+	Status   string        `json:"status,omitempty"`
+	Branches []BranchStock `json:"branches,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Cache    string        `json:"cache,omitempty"`
+}
+
+// supplierStock builds a provider's answer from its branch stock
+func supplierStock(supplier string, branches []BranchStock, cache string) SupplierStock {
+	if branches == nil {
+		branches = []BranchStock{}
+	}
+	return SupplierStock{Supplier: supplier, Status: BranchStockStatus(branches), Branches: branches, Cache: cache}
+}
+
+// StockProviders is the registry of merchants stock can be checked at. The
+// first provider registered is the default.
+type StockProviders struct {
+	mu        sync.RWMutex
+	providers map[string]StockProvider
+	order     []string
+	// cache, when set, answers repeated lookups without asking the merchant
+	cache *StockCache
+}
+
+// NewStockProviders returns a registry holding providers
+func NewStockProviders(providers ...StockProvider) (*StockProviders, error) {
+	r := &StockProviders{providers: map[string]StockProvider{}}
+	for _, provider := range providers {
+		if err := r.Register(provider); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// NewDefaultStockProviders returns a registry of the built-in merchants,
+// sharing one outbound SupplierClient
+func NewDefaultStockProviders() *StockProviders {
+	client := NewSupplierClient()
+	r, err := NewStockProviders(NewTravisPerkinsProvider(client))
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds a provider. Names must be unique and cannot be "all".
+func (r *StockProviders) Register(provider StockProvider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := provider.Name()
+	if name == "" || name == AllStockProviders {
+		return fmt.Errorf("invalid stock provider name %q", name)
+	}
+	if _, ok := r.providers[name]; ok {
+		return fmt.Errorf("stock provider %q is already registered", name)
+	}
+	r.providers[name] = provider
+	r.order = append(r.order, name)
+	return nil
+}
+
+// SetCache routes every lookup through cache; nil asks the merchants directly
+func (r *StockProviders) SetCache(cache *StockCache) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = cache
+}
+
+// availability asks provider about query, through the cache if one is set
+func (r *StockProviders) availability(ctx context.Context, provider StockProvider, query StockQuery) ([]BranchStock, string, error) {
+	r.mu.RLock()
+	cache := r.cache
+	r.mu.RUnlock()
+	if cache == nil {
+		branches, err := provider.Availability(ctx, query)
+		return branches, "", err
+	}
+	return cache.Lookup(ctx, provider, query)
+}
+
+// Names returns the registered provider names in sorted order
+func (r *StockProviders) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.namesLocked()
+}
+
+// Lookup returns the named provider, or the default provider for an empty name
+func (r *StockProviders) Lookup(name string) (StockProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if name == "" {
+		if len(r.order) == 0 {
+			return nil, fmt.Errorf("%w: none are registered", ErrUnknownStockProvider)
+		}
+		name = r.order[0]
+	}
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q (available: %s)", ErrUnknownStockProvider, name, strings.Join(r.namesLocked(), ", "))
+	}
+	return provider, nil
+}
+
+func (r *StockProviders) namesLocked() []string {
+	names := append([]string(nil), r.order...)
+	sort.Strings(names)
+	return names
+}
+
+// StockStatus asks the named provider, or the default one, about query
+func (r *StockProviders) StockStatus(ctx context.Context, supplier string, query StockQuery) (SupplierStock, error) {
+	provider, err := r.Lookup(supplier)
+	if err != nil {
+		return SupplierStock{}, err
+	}
+	branches, cache, err := r.availability(ctx, provider, query)
+	if err != nil {
+		return SupplierStock{}, err
+	}
+	return supplierStock(provider.Name(), branches, cache), nil
+}
+
+// FanOut asks every provider about query concurrently. The results are in
+// supplier name order, one per provider; failures are reported per supplier.
+func (r *StockProviders) FanOut(ctx context.Context, query StockQuery) []SupplierStock {
+	r.mu.RLock()
+	providers := make([]StockProvider, 0, len(r.order))
+	for _, name := range r.namesLocked() {
+		providers = append(providers, r.providers[name])
+	}
+	r.mu.RUnlock()
+
+	results := make([]SupplierStock, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, stockProviderTimeout)
+			defer cancel()
+
+			branches, cache, err := r.availability(ctx, provider, query)
+			if err != nil {
+				results[i] = SupplierStock{Supplier: provider.Name(), Error: err.Error(), Cache: cache}
+				return
+			}
+			results[i] = supplierStock(provider.Name(), branches, cache)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// MergeStockStatus summarises a fan-out: InStock if any supplier has stock,
+// otherwise OutOfStock if any stocks the product, otherwise NotAvailable.
+// It returns an empty status if every supplier failed.
+func MergeStockStatus(results []SupplierStock) string {
+	merged := ""
+	for _, result := range results {
+		switch result.Status {
+		case StockInStock:
+			return StockInStock
+		case StockOutOfStock:
+			merged = StockOutOfStock
+		case StockNotAvailable:
+			if merged == "" {
+				merged = StockNotAvailable
+			}
+		}
+	}
+	return merged
+}
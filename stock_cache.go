@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default lifetimes of cached stock lookups. A product a merchant does not
+// sell near a postcode rarely starts selling there, so NotAvailable answers
+// are kept longer than stock levels.
+const (
+	defaultStockCacheTTL         = 2 * time.Minute
+	defaultStockCacheNegativeTTL = 15 * time.Minute
+)
+
+// stockCacheSweepSize is the number of entries above which storing a lookup
+// first removes expired entries, bounding the memory held for old queries
+const stockCacheSweepSize = 1024
+
+// How a stock lookup was answered, reported as "cache" in stock responses
+const (
+	// StockCacheHit is a lookup answered from the cache
+	StockCacheHit = "hit"
+	// StockCacheMiss is a lookup that asked the merchant
+	StockCacheMiss = "miss"
+	// StockCacheCoalesced is a lookup that waited for an identical lookup
+	// already asking the merchant and shared its answer
+	StockCacheCoalesced = "coalesced"
+)
+
+// StockCache remembers merchant answers for a while and shares one request
+// between concurrent identical lookups. Errors are never cached.
+type StockCache struct {
+	// TTL is how long stock levels are kept; 0 disables caching, though
+	// concurrent identical lookups are still coalesced
+	TTL time.Duration
+	// NegativeTTL is how long NotAvailable answers are kept
+	NegativeTTL time.Duration
+
+	mu       sync.Mutex
+	entries  map[stockCacheKey]stockCacheEntry
+	inflight map[stockCacheKey]*stockLookup
+}
+
+// stockCacheKey identifies a lookup: the merchant and every query option
+type stockCacheKey struct {
+	supplier string
+	query    StockQuery
+}
+
+type stockCacheEntry struct {
+	branches []BranchStock
+	expires  time.Time
+}
+
+// stockLookup is a merchant request that other lookups can wait for
+type stockLookup struct {
+	done     chan struct{}
+	branches []BranchStock
+	err      error
+}
+
+// NewStockCache returns a cache keeping stock levels for ttl and NotAvailable
+// answers for negativeTTL
+func NewStockCache(ttl, negativeTTL time.Duration) *StockCache {
+	return &StockCache{
+		TTL:         ttl,
+		NegativeTTL: negativeTTL,
+		entries:     map[stockCacheKey]stockCacheEntry{},
+		inflight:    map[stockCacheKey]*stockLookup{},
+	}
+}
+
+// NewStockCacheFromEnv builds the cache configured by STOCK_CACHE_TTL and
+// STOCK_CACHE_NEGATIVE_TTL, given as Go durations such as "90s" or "10m".
+// Unset values take the defaults; "0" disables that kind of caching.
+func NewStockCacheFromEnv() (*StockCache, error) {
+	ttl, err := durationFromEnv("STOCK_CACHE_TTL", defaultStockCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	negativeTTL, err := durationFromEnv("STOCK_CACHE_NEGATIVE_TTL", defaultStockCacheNegativeTTL)
+	if err != nil {
+		return nil, err
+	}
+	return NewStockCache(ttl, negativeTTL), nil
+}
+
+// durationFromEnv parses the named environment variable as a non-negative duration
+func durationFromEnv(name string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s %q (expected a duration such as \"90s\" or \"10m\")", name, value)
+	}
+	return d, nil
+}
+
+// Lookup returns provider's answer to query from the cache, by joining an
+// identical lookup in flight, or by asking the provider. It also reports
+// which of these happened. Each lookup stops waiting when its own ctx is
+// done. The merchant request carries the values of the ctx that started it
+// but not its deadline or cancellation, since other lookups with more time
+// left may be waiting for it; it is bounded by the provider timeout instead.
+func (c *StockCache) Lookup(ctx context.Context, provider StockProvider, query StockQuery) ([]BranchStock, string, error) {
+	// The key and the merchant request outlive the caller, whose strings may
+	// alias a request buffer that fasthttp reuses
+	query.ProductID = strings.Clone(query.ProductID)
+	query.Postcode = strings.Clone(query.Postcode)
+	query.BranchID = strings.Clone(query.BranchID)
+	key := stockCacheKey{supplier: provider.Name(), query: query}
+	key.query.Postcode = normalisePostcode(query.Postcode)
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		if time.Now().Before(entry.expires) {
+			c.mu.Unlock()
+			return entry.branches, StockCacheHit, nil
+		}
+		delete(c.entries, key)
+	}
+	if lookup, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-lookup.done:
+			return lookup.branches, StockCacheCoalesced, lookup.err
+		case <-ctx.Done():
+			return nil, StockCacheCoalesced, ctx.Err()
+		}
+	}
+	lookup := &stockLookup{done: make(chan struct{})}
+	c.inflight[key] = lookup
+	c.mu.Unlock()
+
+	go c.fetch(ctx, key, provider, query, lookup)
+
+	select {
+	case <-lookup.done:
+		return lookup.branches, StockCacheMiss, lookup.err
+	case <-ctx.Done():
+		return nil, StockCacheMiss, ctx.Err()
+	}
+}
+
+// fetch asks the provider, stores a successful answer and wakes the waiters
+func (c *StockCache) fetch(caller context.Context, key stockCacheKey, provider StockProvider, query StockQuery, lookup *stockLookup) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(caller), stockProviderTimeout)
+	defer cancel()
+	lookup.branches, lookup.err = provider.Availability(ctx, query)
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if ttl := c.ttlFor(lookup.branches); lookup.err == nil && ttl > 0 {
+		now := time.Now()
+		if len(c.entries) >= stockCacheSweepSize {
+			for k, entry := range c.entries {
+				if !now.Before(entry.expires) {
+					delete(c.entries, k)
+				}
+			}
+		}
+		c.entries[key] = stockCacheEntry{branches: lookup.branches, expires: now.Add(ttl)}
+	}
+	c.mu.Unlock()
+	close(lookup.done)
+}
+
+// ttlFor returns how long an answer listing branches is kept
+func (c *StockCache) ttlFor(branches []BranchStock) time.Duration {
+	if len(branches) == 0 {
+		return c.NegativeTTL
+	}
+	return c.TTL
+}
+
+// normalisePostcode makes "sw1a 1aa" and "SW1A1AA" share a cache entry
+func normalisePostcode(postcode string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(postcode), ""))
+}
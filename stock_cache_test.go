@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// stubStockProvider answers with branches once release is closed, reporting
+// each call's context on calls
+type stubStockProvider struct {
+	calls    chan context.Context
+	release  chan struct{}
+	branches []BranchStock
+}
+
+func newStubStockProvider(branches []BranchStock) *stubStockProvider {
+	return &stubStockProvider{calls: make(chan context.Context, 16), release: make(chan struct{}), branches: branches}
+}
+
+func (p *stubStockProvider) Name() string { return "stub" }
+
+func (p *stubStockProvider) Availability(ctx context.Context, query StockQuery) ([]BranchStock, error) {
+	p.calls <- ctx
+	select {
+	case <-p.release:
+		return p.branches, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// A waiter with time left must get the shared answer even after the lookup
+// that started the merchant request has hit its own deadline
+func TestStockCacheWaiterOutlivesLeaderDeadline(t *testing.T) {
+	cache := NewStockCache(time.Minute, time.Minute)
+	provider := newStubStockProvider([]BranchStock{{BranchID: "B1", StockLevel: 3, StockUOM: "EA"}})
+	query := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+
+	leaderCtx, cancelLeader := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancelLeader()
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, _, err := cache.Lookup(leaderCtx, provider, query)
+		leaderErr <- err
+	}()
+	fetchCtx := <-provider.calls
+
+	type answer struct {
+		branches []BranchStock
+		source   string
+		err      error
+	}
+	waiter := make(chan answer, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		branches, source, err := cache.Lookup(ctx, provider, query)
+		waiter <- answer{branches, source, err}
+	}()
+
+	if err := <-leaderErr; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("leader got %v, want its own deadline", err)
+	}
+	if err := fetchCtx.Err(); err != nil {
+		t.Fatalf("shared merchant request ended with the leader: %v", err)
+	}
+	if deadline, ok := fetchCtx.Deadline(); !ok || time.Until(deadline) < stockProviderTimeout-time.Second {
+		t.Errorf("shared merchant request deadline %v, want the provider timeout", deadline)
+	}
+	close(provider.release)
+
+	got := <-waiter
+	if got.err != nil || got.source != StockCacheCoalesced || len(got.branches) != 1 {
+		t.Fatalf("waiter got %v (%s, %d branches), want the coalesced answer", got.err, got.source, len(got.branches))
+	}
+}
+
+// fakeMerchant is an httptest server answering the Travis Perkins GraphQL
+// availability operation from stock, keyed by product ID. Unknown products
+// have no branches. While hold is set, requests wait for it to be closed.
+// bodies records every request body received.
+type fakeMerchant struct {
+	*httptest.Server
+	mu       sync.Mutex
+	stock    map[string][]BranchStock
+	status   int
+	hold     chan struct{}
+	requests int
+	bodies   [][]byte
+}
+
+func newFakeMerchant(t *testing.T, stock map[string][]BranchStock) *fakeMerchant {
+	t.Helper()
+	m := &fakeMerchant{stock: stock}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serve))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *fakeMerchant) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req GraphQLRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m.mu.Lock()
+	m.requests++
+	m.bodies = append(m.bodies, body)
+	status, hold, branches := m.status, m.hold, m.stock[req.Variables.ProductID]
+	m.mu.Unlock()
+	if hold != nil {
+		<-hold
+	}
+	if status != 0 {
+		w.WriteHeader(status)
+		return
+	}
+
+	// BranchStock shares the merchant's JSON field names
+	if branches == nil {
+		branches = []BranchStock{}
+	}
+	resp := map[string]any{"data": map[string]any{"tpplcBrand": map[string]any{"productCollectionAvailability": branches}}}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (m *fakeMerchant) set(update func(m *fakeMerchant)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	update(m)
+}
+
+// variables returns the GraphQL variables of every request, as sent
+func (m *fakeMerchant) variables(t *testing.T) []map[string]any {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	variables := make([]map[string]any, len(m.bodies))
+	for i, body := range m.bodies {
+		var req struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Fatal(err)
+		}
+		variables[i] = req.Variables
+	}
+	return variables
+}
+
+func (m *fakeMerchant) requestCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests
+}
+
+// provider returns a Travis Perkins provider pointed at the fake merchant,
+// with a client that retries quickly
+func (m *fakeMerchant) provider() *TravisPerkinsProvider {
+	client := NewSupplierClient()
+	client.BaseBackoff, client.MaxBackoff = time.Millisecond, 10*time.Millisecond
+	return &TravisPerkinsProvider{URL: m.URL, BrandID: travisPerkinsBrandID, Client: client}
+}
+
+// defaultLogOutput restores logging silenced by silenceLog
+var defaultLogOutput = log.Writer()
+
+func silenceLog(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(defaultLogOutput) })
+}
+
+var testBranchStock = map[string][]BranchStock{"P1": {{BranchID: "B1", StockLevel: 3, StockUOM: "EA"}}}
+
+// expectLookup runs one lookup and checks how it was answered
+func expectLookup(t *testing.T, cache *StockCache, provider StockProvider, query StockQuery, want string) []BranchStock {
+	t.Helper()
+	branches, source, err := cache.Lookup(context.Background(), provider, query)
+	if err != nil {
+		t.Fatalf("lookup %+v: %v", query, err)
+	}
+	if source != want {
+		t.Fatalf("lookup %+v answered by %s, want %s", query, source, want)
+	}
+	return branches
+}
+
+func TestStockCacheHitAndMiss(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, testBranchStock)
+	provider := merchant.provider()
+	cache := NewStockCache(time.Minute, time.Minute)
+
+	query := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+	if branches := expectLookup(t, cache, provider, query, StockCacheMiss); len(branches) != 1 {
+		t.Fatalf("got %d branches, want 1", len(branches))
+	}
+	expectLookup(t, cache, provider, query, StockCacheHit)
+	// Postcodes match regardless of case and spacing
+	expectLookup(t, cache, provider, StockQuery{ProductID: "P1", Postcode: "sw1a1aa"}, StockCacheHit)
+	// Any other option is a different lookup
+	expectLookup(t, cache, provider, StockQuery{ProductID: "P1", Postcode: "SW1A 1AA", BranchLimit: 2}, StockCacheMiss)
+
+	if n := merchant.requestCount(); n != 2 {
+		t.Fatalf("merchant asked %d times, want 2", n)
+	}
+}
+
+// NotAvailable answers are kept for NegativeTTL, stock levels for TTL
+func TestStockCacheNegativeTTL(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, testBranchStock)
+	provider := merchant.provider()
+	cache := NewStockCache(time.Minute, 50*time.Millisecond)
+
+	stocked := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+	unlisted := StockQuery{ProductID: "P2", Postcode: "SW1A 1AA"}
+	expectLookup(t, cache, provider, stocked, StockCacheMiss)
+	if branches := expectLookup(t, cache, provider, unlisted, StockCacheMiss); len(branches) != 0 {
+		t.Fatalf("got %d branches for an unlisted product", len(branches))
+	}
+	expectLookup(t, cache, provider, unlisted, StockCacheHit)
+
+	time.Sleep(100 * time.Millisecond)
+	expectLookup(t, cache, provider, unlisted, StockCacheMiss)
+	expectLookup(t, cache, provider, stocked, StockCacheHit)
+}
+
+// A TTL of zero stores nothing, but NotAvailable answers still use NegativeTTL
+func TestStockCacheZeroTTL(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, testBranchStock)
+	provider := merchant.provider()
+
+	cache := NewStockCache(0, time.Minute)
+	stocked := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+	expectLookup(t, cache, provider, stocked, StockCacheMiss)
+	expectLookup(t, cache, provider, stocked, StockCacheMiss)
+	unlisted := StockQuery{ProductID: "P2", Postcode: "SW1A 1AA"}
+	expectLookup(t, cache, provider, unlisted, StockCacheMiss)
+	expectLookup(t, cache, provider, unlisted, StockCacheHit)
+
+	cache = NewStockCache(0, 0)
+	expectLookup(t, cache, provider, unlisted, StockCacheMiss)
+	expectLookup(t, cache, provider, unlisted, StockCacheMiss)
+	if n := merchant.requestCount(); n != 5 {
+		t.Fatalf("merchant asked %d times, want 5", n)
+	}
+}
+
+func TestStockCacheDoesNotCacheErrors(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, testBranchStock)
+	provider := merchant.provider()
+	provider.Client.MaxAttempts = 1
+	cache := NewStockCache(time.Minute, time.Minute)
+	query := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+
+	merchant.set(func(m *fakeMerchant) { m.status = http.StatusBadRequest })
+	if _, source, err := cache.Lookup(context.Background(), provider, query); err == nil || source != StockCacheMiss {
+		t.Fatalf("got %v (%s), want a missed lookup failing", err, source)
+	}
+	merchant.set(func(m *fakeMerchant) { m.status = 0 })
+	expectLookup(t, cache, provider, query, StockCacheMiss)
+}
+
+// Concurrent identical lookups share one merchant request, even with
+// caching disabled
+func TestStockCacheCoalescesConcurrentLookups(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, testBranchStock)
+	hold := make(chan struct{})
+	merchant.set(func(m *fakeMerchant) { m.hold = hold })
+	provider := merchant.provider()
+	cache := NewStockCache(0, 0)
+	query := StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"}
+
+	const lookups = 8
+	sources := make(chan string, lookups)
+	var wg sync.WaitGroup
+	for i := 0; i < lookups; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			branches, source, err := cache.Lookup(context.Background(), provider, query)
+			if err != nil || len(branches) != 1 {
+				t.Errorf("lookup got %d branches, %v", len(branches), err)
+			}
+			sources <- source
+		}()
+	}
+	// Give every lookup time to join the one waiting on the merchant
+	time.Sleep(100 * time.Millisecond)
+	close(hold)
+	wg.Wait()
+	close(sources)
+
+	counts := map[string]int{}
+	for source := range sources {
+		counts[source]++
+	}
+	if counts[StockCacheMiss] != 1 || counts[StockCacheCoalesced] != lookups-1 {
+		t.Fatalf("answered %v, want 1 miss and %d coalesced", counts, lookups-1)
+	}
+	if n := merchant.requestCount(); n != 1 {
+		t.Fatalf("merchant asked %d times, want 1", n)
+	}
+}
+
+// recordingStockProvider answers at once and records each query it is asked
+type recordingStockProvider struct {
+	mu      sync.Mutex
+	queries []StockQuery
+}
+
+func (p *recordingStockProvider) Name() string { return "recording" }
+
+func (p *recordingStockProvider) Availability(ctx context.Context, query StockQuery) ([]BranchStock, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.queries = append(p.queries, query)
+	return nil, nil
+}
+
+// Query strings alias fasthttp's request buffers, which are reused for the
+// next request; the cache must not keep them
+func TestStockCacheKeepsQueryParametersAfterRequest(t *testing.T) {
+	provider := &recordingStockProvider{}
+	stock, err := NewStockProviders(provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewStockCache(time.Minute, time.Minute)
+	stock.SetCache(cache)
+	handlers := &httpHandlers{stock: stock}
+	app := fiber.New()
+	app.Get("/stock", handlers.getStockStatus)
+
+	want := []StockQuery{
+		{ProductID: "AAAA", Postcode: "SW1A1AA", BranchID: "B1"},
+		{ProductID: "BBBB", Postcode: "XX9X9XX", BranchID: "B2"},
+		{ProductID: "CCCC", Postcode: "YY9Y9YY", BranchID: "B3"},
+	}
+	for _, query := range want {
+		target := "/stock?productId=" + query.ProductID + "&postcode=" + query.Postcode + "&branchId=" + query.BranchID
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s: status %d", target, resp.StatusCode)
+		}
+	}
+
+	provider.mu.Lock()
+	asked := slices.Clone(provider.queries)
+	provider.mu.Unlock()
+	if !slices.Equal(asked, want) {
+		t.Errorf("provider asked %+v, want %+v", asked, want)
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for _, query := range want {
+		if _, ok := cache.entries[stockCacheKey{supplier: provider.Name(), query: query}]; !ok {
+			t.Errorf("no cache entry for %+v", query)
+		}
+	}
+	if len(cache.entries) != len(want) {
+		t.Errorf("cache holds %d entries, want %d", len(cache.entries), len(want))
+	}
+}
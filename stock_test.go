@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pb "formandfunction-api/proto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// staticStockProvider answers every query with the same branches or error
+type staticStockProvider struct {
+	name     string
+	branches []BranchStock
+	err      error
+}
+
+func (p staticStockProvider) Name() string { return p.name }
+
+func (p staticStockProvider) Availability(ctx context.Context, query StockQuery) ([]BranchStock, error) {
+	return p.branches, p.err
+}
+
+var (
+	stockedBranch = BranchStock{BranchID: "B1", StockLevel: 3, StockUOM: "EA"}
+	emptyBranch   = BranchStock{BranchID: "B2", StockLevel: 0, StockUOM: "EA"}
+)
+
+func TestBranchStockStatus(t *testing.T) {
+	tests := []struct {
+		branches []BranchStock
+		want     string
+	}{
+		{nil, StockNotAvailable},
+		{[]BranchStock{}, StockNotAvailable},
+		{[]BranchStock{emptyBranch}, StockOutOfStock},
+		{[]BranchStock{emptyBranch, stockedBranch}, StockInStock},
+		{[]BranchStock{{BranchID: "B3", StockLevel: 0.5, StockUOM: "M"}}, StockInStock},
+	}
+	for _, tt := range tests {
+		if got := BranchStockStatus(tt.branches); got != tt.want {
+			t.Errorf("BranchStockStatus(%+v) = %s, want %s", tt.branches, got, tt.want)
+		}
+	}
+}
+
+// The gRPC response carries each supplier's branches field for field
+func TestGetStockStatusRPCBranches(t *testing.T) {
+	stock, err := NewStockProviders(
+		staticStockProvider{name: "a", branches: []BranchStock{stockedBranch, emptyBranch}},
+		staticStockProvider{name: "b", branches: []BranchStock{emptyBranch}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	silenceLog(t)
+	s := &server{stock: stock}
+	branches := func(in []*pb.BranchStock) []BranchStock {
+		out := make([]BranchStock, len(in))
+		for i, b := range in {
+			out[i] = BranchStock{BranchID: b.BranchId, StockLevel: b.StockLevel, StockUOM: b.StockUom}
+		}
+		return out
+	}
+
+	resp, err := s.GetStockStatus(context.Background(), &pb.GetStockStatusRequest{ProductId: "P1", Postcode: "SW1A1AA"})
+	if err != nil || !resp.Success {
+		t.Fatalf("GetStockStatus: %v %s", err, resp.GetMessage())
+	}
+	if got, want := branches(resp.Branches), []BranchStock{stockedBranch, emptyBranch}; resp.Status != StockInStock || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s with %+v, want %s with %+v", resp.Status, got, StockInStock, want)
+	}
+
+	resp, err = s.GetStockStatus(context.Background(), &pb.GetStockStatusRequest{ProductId: "P1", Postcode: "SW1A1AA", Supplier: AllStockProviders})
+	if err != nil || !resp.Success || len(resp.Suppliers) != 2 {
+		t.Fatalf("GetStockStatus all: %v %+v", err, resp)
+	}
+	if got := branches(resp.Suppliers[1].Branches); resp.Suppliers[1].Status != StockOutOfStock || !reflect.DeepEqual(got, []BranchStock{emptyBranch}) {
+		t.Errorf("supplier b: %s with %+v", resp.Suppliers[1].Status, got)
+	}
+}
+
+func TestStockProvidersRegister(t *testing.T) {
+	r, err := NewStockProviders(staticStockProvider{name: "first"}, staticStockProvider{name: "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"", AllStockProviders, "second"} {
+		if err := r.Register(staticStockProvider{name: name}); err == nil {
+			t.Errorf("Register(%q): expected an error", name)
+		}
+	}
+	if provider, err := r.Lookup(""); err != nil || provider.Name() != "first" {
+		t.Errorf("default provider %v, %v; want the first registered", provider, err)
+	}
+	if _, err := r.Lookup("third"); !errors.Is(err, ErrUnknownStockProvider) {
+		t.Errorf("Lookup(third) = %v, want ErrUnknownStockProvider", err)
+	}
+	if _, err := (&StockProviders{providers: map[string]StockProvider{}}).Lookup(""); !errors.Is(err, ErrUnknownStockProvider) {
+		t.Errorf("default of an empty registry = %v, want ErrUnknownStockProvider", err)
+	}
+}
+
+// FanOut answers once per provider in name order, whichever were
+// registered first, and reports failures per supplier
+func TestStockProvidersFanOut(t *testing.T) {
+	r, err := NewStockProviders(
+		staticStockProvider{name: "zeta", branches: []BranchStock{emptyBranch}},
+		staticStockProvider{name: "alpha", err: errors.New("merchant down")},
+		staticStockProvider{name: "mu", branches: []BranchStock{emptyBranch, stockedBranch}},
+		staticStockProvider{name: "beta"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := r.FanOut(context.Background(), StockQuery{ProductID: "P1", Postcode: "SW1A 1AA"})
+	want := []SupplierStock{
+		{Supplier: "alpha", Error: "merchant down"},
+		{Supplier: "beta", Status: StockNotAvailable, Branches: []BranchStock{}},
+		{Supplier: "mu", Status: StockInStock, Branches: []BranchStock{emptyBranch, stockedBranch}},
+		{Supplier: "zeta", Status: StockOutOfStock, Branches: []BranchStock{emptyBranch}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("fan-out:\n got %+v\nwant %+v", got, want)
+	}
+	if status := MergeStockStatus(got); status != StockInStock {
+		t.Errorf("merged status %s, want %s", status, StockInStock)
+	}
+}
+
+func TestMergeStockStatus(t *testing.T) {
+	failed := SupplierStock{Supplier: "a", Error: "down"}
+	inStock := SupplierStock{Supplier: "b", Status: StockInStock}
+	outOfStock := SupplierStock{Supplier: "c", Status: StockOutOfStock}
+	notAvailable := SupplierStock{Supplier: "d", Status: StockNotAvailable}
+	tests := []struct {
+		name    string
+		results []SupplierStock
+		want    string
+	}{
+		{"none", nil, ""},
+		{"all failed", []SupplierStock{failed, failed}, ""},
+		{"in stock anywhere", []SupplierStock{notAvailable, failed, outOfStock, inStock}, StockInStock},
+		{"out of stock beats not available", []SupplierStock{notAvailable, outOfStock, notAvailable}, StockOutOfStock},
+		{"not available", []SupplierStock{failed, notAvailable}, StockNotAvailable},
+	}
+	for _, tt := range tests {
+		if got := MergeStockStatus(tt.results); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// newTestStockApp serves GET /stock from providers
+func newTestStockApp(t *testing.T, providers ...StockProvider) *fiber.App {
+	t.Helper()
+	stock, err := NewStockProviders(providers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlers := &httpHandlers{stock: stock}
+	app := fiber.New()
+	app.Get("/stock", handlers.getStockStatus)
+	return app
+}
+
+// getStock sends GET target and decodes the JSON response into out
+func getStock(t *testing.T, app *fiber.App, target string, out any) int {
+	t.Helper()
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		t.Fatalf("GET %s: %v: %s", target, err, body)
+	}
+	return resp.StatusCode
+}
+
+func TestGetStockStatusAllSuppliers(t *testing.T) {
+	type response struct {
+		Status    string          `json:"status"`
+		Suppliers []SupplierStock `json:"suppliers"`
+	}
+	down := errors.New("merchant down")
+
+	app := newTestStockApp(t,
+		staticStockProvider{name: "a", err: down},
+		staticStockProvider{name: "b", branches: []BranchStock{emptyBranch}},
+	)
+	var merged response
+	if status := getStock(t, app, "/stock?productId=P1&postcode=SW1A1AA&supplier=all", &merged); status != http.StatusOK {
+		t.Fatalf("status %d, want 200", status)
+	}
+	want := []SupplierStock{
+		{Supplier: "a", Error: "merchant down"},
+		{Supplier: "b", Status: StockOutOfStock, Branches: []BranchStock{emptyBranch}},
+	}
+	if merged.Status != StockOutOfStock || !reflect.DeepEqual(merged.Suppliers, want) {
+		t.Errorf("got %+v, want %s with %+v", merged, StockOutOfStock, want)
+	}
+
+	app = newTestStockApp(t, staticStockProvider{name: "a", err: down}, staticStockProvider{name: "b", err: down})
+	var failed response
+	if status := getStock(t, app, "/stock?productId=P1&postcode=SW1A1AA&supplier=all", &failed); status != http.StatusBadGateway {
+		t.Fatalf("status %d with every supplier down, want 502", status)
+	}
+	if failed.Status != "" || len(failed.Suppliers) != 2 {
+		t.Errorf("got %+v, want no status and both failures", failed)
+	}
+}
+
+func TestGetStockStatusSupplier(t *testing.T) {
+	app := newTestStockApp(t,
+		staticStockProvider{name: "a", branches: []BranchStock{stockedBranch}},
+		staticStockProvider{name: "b"},
+	)
+	tests := []struct {
+		target string
+		status int
+		want   string
+	}{
+		{"/stock?productId=P1&postcode=SW1A1AA", http.StatusOK, "a"},
+		{"/stock?productId=P1&postcode=SW1A1AA&supplier=b", http.StatusOK, "b"},
+		{"/stock?productId=P1&postcode=SW1A1AA&supplier=c", http.StatusBadRequest, ""},
+		{"/stock?postcode=SW1A1AA", http.StatusBadRequest, ""},
+		{"/stock?productId=P1", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		var got struct {
+			Supplier string `json:"supplier"`
+		}
+		if status := getStock(t, app, tt.target, &got); status != tt.status || got.Supplier != tt.want {
+			t.Errorf("GET %s: %d from %q, want %d from %q", tt.target, status, got.Supplier, tt.status, tt.want)
+		}
+	}
+}
+
+func TestStockQueryValidate(t *testing.T) {
+	tests := []struct {
+		query StockQuery
+		valid bool
+	}{
+		{StockQuery{}, true},
+		{StockQuery{BranchLimit: 3, WithinRadius: 10}, true},
+		{StockQuery{BranchLimit: -1}, false},
+		{StockQuery{WithinRadius: -0.5}, false},
+		{StockQuery{WithinRadius: math.NaN()}, false},
+		{StockQuery{WithinRadius: math.Inf(1)}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.query.Validate(); (err == nil) != tt.valid {
+			t.Errorf("Validate(%+v) = %v, want valid %v", tt.query, err, tt.valid)
+		}
+	}
+}
+
+// Filters given to GET /stock and GetStockStatus reach the merchant as
+// GraphQL variables, and bad ones are rejected before it is asked
+func TestStockFiltersReachMerchant(t *testing.T) {
+	silenceLog(t)
+	merchant := newFakeMerchant(t, nil)
+	app := newTestStockApp(t, merchant.provider())
+	stock, err := NewStockProviders(merchant.provider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{stock: stock}
+
+	var body map[string]any
+	const filters = "branchId=1001&branchLimit=5&withinRadius=12.5"
+	if status := getStock(t, app, "/stock?productId=P1&postcode=SW1A1AA&"+filters, &body); status != http.StatusOK {
+		t.Fatalf("GET /stock: %d %v", status, body)
+	}
+	resp, err := s.GetStockStatus(context.Background(), &pb.GetStockStatusRequest{
+		ProductId: "P2", Postcode: "M11AE", BranchId: "1002", BranchLimit: 2, WithinRadius: 3,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("GetStockStatus: %v %s", err, resp.GetMessage())
+	}
+	want := []map[string]any{
+		{"productId": "P1", "postcode": "SW1A1AA", "brandId": travisPerkinsBrandID,
+			"branchId": "1001", "branchLimit": 5.0, "withinRadius": 12.5},
+		{"productId": "P2", "postcode": "M11AE", "brandId": travisPerkinsBrandID,
+			"branchId": "1002", "branchLimit": 2.0, "withinRadius": 3.0},
+	}
+	if got := merchant.variables(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("variables:\n got %v\nwant %v", got, want)
+	}
+
+	for _, bad := range []string{"branchLimit=-1", "branchLimit=two", "withinRadius=-2", "withinRadius=NaN", "withinRadius=far"} {
+		if status := getStock(t, app, "/stock?productId=P1&postcode=SW1A1AA&"+bad, &body); status != http.StatusBadRequest {
+			t.Errorf("GET /stock with %s: %d, want 400", bad, status)
+		}
+	}
+	resp, err = s.GetStockStatus(context.Background(), &pb.GetStockStatusRequest{ProductId: "P1", Postcode: "M11AE", BranchLimit: -1})
+	if err != nil || resp.Success {
+		t.Errorf("GetStockStatus with a negative branch limit: %v %+v", err, resp)
+	}
+	if n := merchant.requestCount(); n != 2 {
+		t.Errorf("merchant asked %d times, want 2", n)
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Outbound request defaults for supplier lookups. A lookup makes at most
+// supplierMaxAttempts requests, each bounded by supplierAttemptTimeout, and
+// the whole lookup is bounded by the caller's context.
+const (
+	supplierAttemptTimeout = 5 * time.Second
+	supplierMaxAttempts    = 3
+	supplierBaseBackoff    = 200 * time.Millisecond
+	supplierMaxBackoff     = 2 * time.Second
+)
+
+// Circuit breaker defaults: a provider failing this many lookups in a row is
+// not called again until the open period has passed
+const (
+	supplierBreakerThreshold = 5
+	supplierBreakerOpenFor   = 30 * time.Second
+)
+
+// ErrSupplierCircuitOpen is returned without calling a provider whose
+// circuit breaker is open
+var ErrSupplierCircuitOpen = errors.New("supplier circuit breaker is open")
+
+// SupplierClient is the outbound HTTP client shared by stock providers. It
+// retries transport errors, 5xx and 429 responses with jittered exponential
+// backoff, and keeps a circuit breaker per provider so a failing merchant is
+// skipped quickly instead of tying up request handlers.
+type SupplierClient struct {
+	HTTP *http.Client
+	// MaxAttempts is the number of requests made per call, including the first
+	MaxAttempts int
+	// BaseBackoff and MaxBackoff bound the wait before each retry, which
+	// doubles per attempt and is drawn at random below that bound
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BreakerThreshold consecutive failed calls open a provider's breaker
+	// for BreakerOpenFor
+	BreakerThreshold int
+	BreakerOpenFor   time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewSupplierClient returns a client with the default timeouts, retries and
+// circuit breaker settings
+func NewSupplierClient() *SupplierClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: supplierAttemptTimeout, KeepAlive: 30 * time.Second}).DialContext
+	transport.TLSHandshakeTimeout = supplierAttemptTimeout
+	transport.ResponseHeaderTimeout = supplierAttemptTimeout
+	return &SupplierClient{
+		HTTP:             &http.Client{Timeout: supplierAttemptTimeout, Transport: transport},
+		MaxAttempts:      supplierMaxAttempts,
+		BaseBackoff:      supplierBaseBackoff,
+		MaxBackoff:       supplierMaxBackoff,
+		BreakerThreshold: supplierBreakerThreshold,
+		BreakerOpenFor:   supplierBreakerOpenFor,
+		breakers:         map[string]*circuitBreaker{},
+	}
+}
+
+// Do sends req on behalf of the named provider, retrying as needed. The
+// request's context cancels the call, including any wait between retries.
+// Requests with a body must be replayable, i.e. have GetBody set, as
+// http.NewRequest does for in-memory bodies. If every attempt gets a
+// retryable status, the last response is returned for the caller to report.
+func (c *SupplierClient) Do(provider string, req *http.Request) (*http.Response, error) {
+	breaker := c.breaker(provider)
+	if retryAt, ok := breaker.allow(); !ok {
+		wait := max(time.Until(retryAt), time.Second)
+		return nil, fmt.Errorf("%w for %s; retry after %s", ErrSupplierCircuitOpen, provider, wait.Round(time.Second))
+	}
+
+	resp, err := c.send(provider, req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		// The caller gave up or ran out of time, which says nothing about
+		// the provider; a slow provider still fails the per-attempt timeout
+		breaker.release()
+	case err != nil || retryableStatus(resp.StatusCode):
+		if breaker.failure(c.BreakerThreshold, c.BreakerOpenFor) {
+			log.Printf("Stock provider %s: circuit breaker opened for %s", provider, c.BreakerOpenFor)
+		}
+	default:
+		if breaker.success() {
+			log.Printf("Stock provider %s: circuit breaker closed", provider)
+		}
+	}
+	return resp, err
+}
+
+// send makes up to MaxAttempts requests, waiting between them
+func (c *SupplierClient) send(provider string, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("cannot retry request to %s: body is not replayable", req.URL.Host)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := c.HTTP.Do(attemptReq)
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= c.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && retryAfter <= c.MaxBackoff {
+				wait = retryAfter
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		log.Printf("Stock provider %s: attempt %d failed (%s), retrying in %s", provider, attempt, reason, wait.Round(time.Millisecond))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// backoff returns a random wait of up to BaseBackoff doubled per attempt,
+// capped at MaxBackoff ("full jitter"), so retries from concurrent lookups
+// spread out instead of arriving together
+func (c *SupplierClient) backoff(attempt int) time.Duration {
+	limit := c.MaxBackoff
+	if shift := attempt - 1; shift < 32 && c.BaseBackoff<<shift < limit {
+		limit = c.BaseBackoff << shift
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return rand.N(limit) + 1
+}
+
+// breaker returns the circuit breaker of the named provider
+func (c *SupplierClient) breaker(provider string) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[provider]
+	if !ok {
+		b = &circuitBreaker{}
+		c.breakers[provider] = b
+	}
+	return b
+}
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds
+func parseRetryAfter(value string) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// circuitBreaker tracks consecutive failures of one provider. Once open it
+// rejects calls until openUntil, then lets a single trial call through
+// (half-open): success closes it, failure opens it again.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+// allow reports whether a call may proceed, or when it may be retried. While
+// the trial call is in flight the retry time may already have passed.
+func (b *circuitBreaker) allow() (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openUntil.IsZero() {
+		return time.Time{}, true
+	}
+	if time.Now().Before(b.openUntil) || b.trial {
+		return b.openUntil, false
+	}
+	b.trial = true
+	return time.Time{}, true
+}
+
+// release ends a call that neither succeeded nor failed
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+// success records a successful call and reports whether it closed the breaker
+func (b *circuitBreaker) success() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	closed := !b.openUntil.IsZero()
+	b.failures, b.openUntil, b.trial = 0, time.Time{}, false
+	return closed
+}
+
+// failure records a failed call and reports whether it opened the breaker
+func (b *circuitBreaker) failure(threshold int, openFor time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	wasTrial := b.trial
+	b.trial = false
+	if wasTrial || b.failures >= threshold {
+		opened := b.openUntil.IsZero() || wasTrial
+		b.openUntil = time.Now().Add(openFor)
+		return opened
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// scriptedSupplier answers with the given statuses in turn, repeating the
+// last one, and sets Retry-After when retryAfter is non-empty
+type scriptedSupplier struct {
+	*httptest.Server
+	mu         sync.Mutex
+	statuses   []int
+	retryAfter string
+	requests   int
+}
+
+func newScriptedSupplier(t *testing.T, statuses ...int) *scriptedSupplier {
+	t.Helper()
+	s := &scriptedSupplier{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		status := s.statuses[min(s.requests, len(s.statuses)-1)]
+		s.requests++
+		retryAfter := s.retryAfter
+		s.mu.Unlock()
+		if retryAfter != "" && status != http.StatusOK {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *scriptedSupplier) script(statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses, s.requests = statuses, 0
+}
+
+func (s *scriptedSupplier) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// testSupplierClient returns a client with short waits
+func testSupplierClient() *SupplierClient {
+	client := NewSupplierClient()
+	client.BaseBackoff, client.MaxBackoff = time.Millisecond, 10*time.Millisecond
+	client.BreakerOpenFor = 100 * time.Millisecond
+	return client
+}
+
+// supplierCall sends one POST through client and returns the final status
+func supplierCall(t *testing.T, ctx context.Context, client *SupplierClient, url string) (int, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(`{"query":"stock"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do("test", req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func TestSupplierClientRetries(t *testing.T) {
+	silenceLog(t)
+	tests := []struct {
+		name     string
+		statuses []int
+		want     int
+		requests int
+	}{
+		{"recovers from 5xx", []int{503, 500, 200}, 200, 3},
+		{"recovers from 429", []int{429, 200}, 200, 2},
+		{"returns the last retryable response", []int{502}, 502, 3},
+		{"does not retry 4xx", []int{404}, 404, 1},
+		{"does not retry success", []int{200}, 200, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supplier := newScriptedSupplier(t, tt.statuses...)
+			status, err := supplierCall(t, context.Background(), testSupplierClient(), supplier.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status != tt.want || supplier.requestCount() != tt.requests {
+				t.Fatalf("got %d after %d requests, want %d after %d", status, supplier.requestCount(), tt.want, tt.requests)
+			}
+		})
+	}
+}
+
+func TestSupplierClientRetriesTransportErrors(t *testing.T) {
+	silenceLog(t)
+	supplier := newScriptedSupplier(t, 200)
+	url := supplier.URL
+	supplier.Close()
+
+	client := testSupplierClient()
+	if _, err := supplierCall(t, context.Background(), client, url); err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+	if client.breaker("test").failures != 1 {
+		t.Fatalf("breaker saw %d failures, want 1", client.breaker("test").failures)
+	}
+}
+
+// A Retry-After within MaxBackoff replaces the jittered backoff; a longer
+// one is ignored so a merchant cannot stall the lookup
+func TestSupplierClientRetryAfter(t *testing.T) {
+	silenceLog(t)
+	supplier := newScriptedSupplier(t, 429, 200)
+	supplier.retryAfter = "1"
+
+	client := testSupplierClient()
+	client.MaxBackoff = 2 * time.Second
+	start := time.Now()
+	if status, err := supplierCall(t, context.Background(), client, supplier.URL); err != nil || status != 200 {
+		t.Fatalf("got %d, %v", status, err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s, want the 1s Retry-After", elapsed)
+	}
+
+	supplier.script(503, 200)
+	client.MaxBackoff = 10 * time.Millisecond
+	start = time.Now()
+	if status, err := supplierCall(t, context.Background(), client, supplier.URL); err != nil || status != 200 {
+		t.Fatalf("got %d, %v", status, err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("retried after %s, want Retry-After above MaxBackoff ignored", elapsed)
+	}
+}
+
+func TestSupplierClientBackoffBounds(t *testing.T) {
+	client := NewSupplierClient()
+	for attempt := 1; attempt <= 8; attempt++ {
+		limit := min(client.BaseBackoff<<(attempt-1), client.MaxBackoff)
+		for i := 0; i < 100; i++ {
+			if wait := client.backoff(attempt); wait <= 0 || wait > limit {
+				t.Fatalf("attempt %d waited %s, want (0, %s]", attempt, wait, limit)
+			}
+		}
+	}
+}
+
+// The breaker opens after BreakerThreshold failed calls, lets one trial
+// through once BreakerOpenFor has passed, reopens if it fails and closes if
+// it succeeds
+func TestSupplierClientCircuitBreaker(t *testing.T) {
+	silenceLog(t)
+	supplier := newScriptedSupplier(t, 500)
+	client := testSupplierClient()
+	client.MaxAttempts = 1
+	client.BreakerThreshold = 2
+
+	for i := 0; i < 2; i++ {
+		if status, err := supplierCall(t, context.Background(), client, supplier.URL); err != nil || status != 500 {
+			t.Fatalf("call %d: got %d, %v", i, status, err)
+		}
+	}
+	if _, err := supplierCall(t, context.Background(), client, supplier.URL); !errors.Is(err, ErrSupplierCircuitOpen) {
+		t.Fatalf("got %v with the breaker open, want ErrSupplierCircuitOpen", err)
+	}
+	if n := supplier.requestCount(); n != 2 {
+		t.Fatalf("supplier called %d times, want 2 while open", n)
+	}
+
+	// Half-open: a failing trial reopens the breaker at once
+	time.Sleep(client.BreakerOpenFor + 20*time.Millisecond)
+	if status, err := supplierCall(t, context.Background(), client, supplier.URL); err != nil || status != 500 {
+		t.Fatalf("trial: got %d, %v", status, err)
+	}
+	if _, err := supplierCall(t, context.Background(), client, supplier.URL); !errors.Is(err, ErrSupplierCircuitOpen) {
+		t.Fatalf("got %v after a failed trial, want ErrSupplierCircuitOpen", err)
+	}
+
+	// A successful trial closes it
+	time.Sleep(client.BreakerOpenFor + 20*time.Millisecond)
+	supplier.script(200)
+	for i := 0; i < 3; i++ {
+		if status, err := supplierCall(t, context.Background(), client, supplier.URL); err != nil || status != 200 {
+			t.Fatalf("call %d after recovery: got %d, %v", i, status, err)
+		}
+	}
+}
+
+// Only one trial call is let through while half-open
+func TestSupplierClientSingleTrial(t *testing.T) {
+	silenceLog(t)
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	supplier := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+	defer supplier.Close()
+
+	client := testSupplierClient()
+	breaker := client.breaker("test")
+	breaker.failure(1, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	trial := make(chan error, 1)
+	go func() {
+		_, err := supplierCall(t, context.Background(), client, supplier.URL)
+		trial <- err
+	}()
+	<-started
+	if _, err := supplierCall(t, context.Background(), client, supplier.URL); !errors.Is(err, ErrSupplierCircuitOpen) {
+		t.Fatalf("second call during the trial got %v, want ErrSupplierCircuitOpen", err)
+	}
+	close(release)
+	if err := <-trial; err != nil {
+		t.Fatalf("trial: %v", err)
+	}
+	if _, ok := breaker.allow(); !ok {
+		t.Fatal("breaker still open after a successful trial")
+	}
+}
+
+// A caller giving up says nothing about the supplier and is not a failure
+func TestSupplierClientCallerCancellationIsNotAFailure(t *testing.T) {
+	silenceLog(t)
+	release := make(chan struct{})
+	supplier := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer supplier.Close()
+	defer close(release)
+
+	client := testSupplierClient()
+	client.BreakerThreshold = 1
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := supplierCall(t, ctx, client, supplier.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want the caller's deadline", err)
+	}
+	if _, ok := client.breaker("test").allow(); !ok {
+		t.Fatal("caller's deadline opened the breaker")
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// UnitSystem selects the units beam properties are reported in
+type UnitSystem string
+
+const (
+	// UnitsCatalogue is the mixed Blue Book units the catalogue stores:
+	// mm, cm², cm⁴, cm³, dm⁶, kg/m
+	UnitsCatalogue UnitSystem = "catalogue"
+	// UnitsSI is SI base units: m, m², m⁴, m³, m⁶, kg/m
+	UnitsSI UnitSystem = "si"
+	// UnitsMillimetre gives every section dimension and property in powers
+	// of mm, as used with stresses in N/mm²; mass and coating areas keep
+	// their catalogue units
+	UnitsMillimetre UnitSystem = "mm"
+	// UnitsImperial is US customary units: in, in², in⁴, in³, in⁶, lb/ft
+	UnitsImperial UnitSystem = "imperial"
+)
+
+// unitSystems lists the supported systems in the order they are documented
+var unitSystems = []UnitSystem{UnitsCatalogue, UnitsSI, UnitsMillimetre, UnitsImperial}
+
+// convertedSignificantFigures bounds the precision of converted values, so
+// conversion does not add spurious digits to tabulated values
+const convertedSignificantFigures = 6
+
+// ParseUnitSystem returns the system named by value; empty means catalogue
+func ParseUnitSystem(value string) (UnitSystem, error) {
+	if value == "" {
+		return UnitsCatalogue, nil
+	}
+	for _, system := range unitSystems {
+		if UnitSystem(value) == system {
+			return system, nil
+		}
+	}
+	return "", fmt.Errorf("units must be one of catalogue, si, mm or imperial")
+}
+
+// unit is a unit symbol and the factor converting a catalogue value into it
+type unit struct {
+	Symbol string
+	Factor float64
+}
+
+// Quantity is the physical quantity a beam property measures, with its unit
+// in each system
+type Quantity struct {
+	Name  string
+	Units map[UnitSystem]unit
+}
+
+var (
+	quantityLength = Quantity{"length", map[UnitSystem]unit{
+		UnitsCatalogue: {"mm", 1}, UnitsSI: {"m", 1e-3}, UnitsMillimetre: {"mm", 1}, UnitsImperial: {"in", 1 / 25.4},
+	}}
+	quantityRadiusOfGyration = Quantity{"length", map[UnitSystem]unit{
+		UnitsCatalogue: {"cm", 1}, UnitsSI: {"m", 1e-2}, UnitsMillimetre: {"mm", 10}, UnitsImperial: {"in", 1 / 2.54},
+	}}
+	quantityArea = Quantity{"area", map[UnitSystem]unit{
+		UnitsCatalogue: {"cm²", 1}, UnitsSI: {"m²", 1e-4}, UnitsMillimetre: {"mm²", 1e2}, UnitsImperial: {"in²", 1 / (2.54 * 2.54)},
+	}}
+	quantitySecondMoment = Quantity{"second_moment_of_area", map[UnitSystem]unit{
+		UnitsCatalogue: {"cm⁴", 1}, UnitsSI: {"m⁴", 1e-8}, UnitsMillimetre: {"mm⁴", 1e4}, UnitsImperial: {"in⁴", 1 / math.Pow(2.54, 4)},
+	}}
+	quantitySectionModulus = Quantity{"section_modulus", map[UnitSystem]unit{
+		UnitsCatalogue: {"cm³", 1}, UnitsSI: {"m³", 1e-6}, UnitsMillimetre: {"mm³", 1e3}, UnitsImperial: {"in³", 1 / math.Pow(2.54, 3)},
+	}}
+	quantityWarpingConstant = Quantity{"warping_constant", map[UnitSystem]unit{
+		UnitsCatalogue: {"dm⁶", 1}, UnitsSI: {"m⁶", 1e-6}, UnitsMillimetre: {"mm⁶", 1e12}, UnitsImperial: {"in⁶", math.Pow(100/25.4, 6)},
+	}}
+	quantityMassPerLength = Quantity{"mass_per_length", map[UnitSystem]unit{
+		UnitsCatalogue: {"kg/m", 1}, UnitsSI: {"kg/m", 1}, UnitsMillimetre: {"kg/m", 1}, UnitsImperial: {"lb/ft", 0.3048 / 0.45359237},
+	}}
+	quantitySurfacePerLength = Quantity{"surface_area_per_length", map[UnitSystem]unit{
+		UnitsCatalogue: {"m²/m", 1}, UnitsSI: {"m²/m", 1}, UnitsMillimetre: {"m²/m", 1}, UnitsImperial: {"ft²/ft", 1 / 0.3048},
+	}}
+	quantitySurfacePerMass = Quantity{"surface_area_per_mass", map[UnitSystem]unit{
+		// Imperial is per US short ton of 2000 lb
+		UnitsCatalogue: {"m²/t", 1}, UnitsSI: {"m²/kg", 1e-3}, UnitsMillimetre: {"m²/t", 1}, UnitsImperial: {"ft²/ton", 907.18474 / 1000 / (0.3048 * 0.3048)},
+	}}
+	quantityDimensionless = Quantity{"dimensionless", map[UnitSystem]unit{
+		UnitsCatalogue: {"", 1}, UnitsSI: {"", 1}, UnitsMillimetre: {"", 1}, UnitsImperial: {"", 1},
+	}}
+)
+
+// beamFieldSchema describes one SteelBeam field
+type beamFieldSchema struct {
+	Quantity    *Quantity
+	Description string
+}
+
+// beamFieldSchemas describes every SteelBeam field by JSON tag
+var beamFieldSchemas = map[string]beamFieldSchema{
+	"section_designation":              {nil, "Serial size and mass, e.g. UB406x178x74"},
+	"mass_per_metre":                   {&quantityMassPerLength, "Mass per unit length"},
+	"depth_of_section":                 {&quantityLength, "Overall depth h"},
+	"width_of_section":                 {&quantityLength, "Flange width b"},
+	"thickness_web":                    {&quantityLength, "Web thickness tw"},
+	"thickness_flange":                 {&quantityLength, "Flange thickness tf"},
+	"root_radius":                      {&quantityLength, "Root radius r"},
+	"depth_between_fillets":            {&quantityLength, "Depth between fillets d"},
+	"ratios_for_local_buckling_web":    {&quantityDimensionless, "Web slenderness cw/tw"},
+	"ratios_for_local_buckling_flange": {&quantityDimensionless, "Flange outstand slenderness cf/tf"},
+	"end_clearance":                    {&quantityLength, "End clearance C for detailing"},
+	"notch":                            {&quantityLength, "Notch width N for detailing"},
+	"dimensions_for_detailing_n":       {&quantityLength, "Notch depth n for detailing"},
+	"surface_area_per_metre":           {&quantitySurfacePerLength, "Painted surface area per unit length"},
+	"surface_area_per_tonne":           {&quantitySurfacePerMass, "Painted surface area per unit mass"},
+	"second_moment_of_area_axis_y":     {&quantitySecondMoment, "Second moment of area Iy about the major axis"},
+	"second_moment_of_area_axis_z":     {&quantitySecondMoment, "Second moment of area Iz about the minor axis"},
+	"radius_of_gyration_axis_y":        {&quantityRadiusOfGyration, "Radius of gyration iy about the major axis"},
+	"radius_of_gyration_axis_z":        {&quantityRadiusOfGyration, "Radius of gyration iz about the minor axis"},
+	"elastic_modulus_axis_y":           {&quantitySectionModulus, "Elastic section modulus Wel,y"},
+	"elastic_modulus_axis_z":           {&quantitySectionModulus, "Elastic section modulus Wel,z"},
+	"plastic_modulus_axis_y":           {&quantitySectionModulus, "Plastic section modulus Wpl,y"},
+	"plastic_modulus_axis_z":           {&quantitySectionModulus, "Plastic section modulus Wpl,z"},
+	"buckling_parameter":               {&quantityDimensionless, "Buckling parameter U"},
+	"torsional_index":                  {&quantityDimensionless, "Torsional index X"},
+	"warping_constant":                 {&quantityWarpingConstant, "Warping constant Iw"},
+	"torsional_constant":               {&quantitySecondMoment, "St Venant torsion constant It"},
+	"area_of_section":                  {&quantityArea, "Gross cross-sectional area A"},
+}
+
+// beamFieldUnit returns the unit of a numeric SteelBeam field in system
+func beamFieldUnit(field string, system UnitSystem) unit {
+	schema, ok := beamFieldSchemas[field]
+	if !ok || schema.Quantity == nil {
+		return unit{Factor: 1}
+	}
+	return schema.Quantity.Units[system]
+}
+
+// ConvertBeam returns beam with every property converted from catalogue
+// units into system, rounded to six significant figures
+func ConvertBeam(beam SteelBeam, system UnitSystem) SteelBeam {
+	if system == UnitsCatalogue {
+		return beam
+	}
+	v := reflect.ValueOf(&beam).Elem()
+	for _, column := range beamColumns {
+		field := v.Field(column.Index)
+		if field.Kind() != reflect.Float64 {
+			continue
+		}
+		field.SetFloat(roundSignificant(field.Float()*beamFieldUnit(column.Name, system).Factor, convertedSignificantFigures))
+	}
+	return beam
+}
+
+// ConvertBeams converts every beam into system
+func ConvertBeams(beams []SteelBeam, system UnitSystem) []SteelBeam {
+	if system == UnitsCatalogue {
+		return beams
+	}
+	converted := make([]SteelBeam, len(beams))
+	for i, beam := range beams {
+		converted[i] = ConvertBeam(beam, system)
+	}
+	return converted
+}
+
+// InUnits returns the query with its filter bounds, given in system, converted
+// to the catalogue units beams are stored in
+func (q BeamQuery) InUnits(system UnitSystem) BeamQuery {
+	if system == UnitsCatalogue {
+		return q
+	}
+	filters := make([]BeamRangeFilter, len(q.Filters))
+	for i, filter := range q.Filters {
+		factor := beamFieldUnit(filter.Field, system).Factor
+		if filter.Min != nil {
+			lower := *filter.Min / factor
+			filter.Min = &lower
+		}
+		if filter.Max != nil {
+			upper := *filter.Max / factor
+			filter.Max = &upper
+		}
+		filters[i] = filter
+	}
+	q.Filters = filters
+	return q
+}
+
+// roundSignificant rounds v to n significant figures
+func roundSignificant(v float64, n int) float64 {
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'g', n, 64), 64)
+	return rounded
+}
+
+// BeamFieldSchema documents one SteelBeam field for GET /beams/schema
+type BeamFieldSchema struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Quantity    string `json:"quantity,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+	Description string `json:"description"`
+}
+
+// BeamSchema lists every SteelBeam field, in struct order, with its unit in
+// the given system
+func BeamSchema(system UnitSystem) []BeamFieldSchema {
+	schema := make([]BeamFieldSchema, 0, len(beamColumns))
+	for _, column := range beamColumns {
+		field := beamFieldSchemas[column.Name]
+		entry := BeamFieldSchema{Name: column.Name, Type: "string", Description: field.Description}
+		if field.Quantity != nil {
+			entry.Type = "number"
+			entry.Quantity = field.Quantity.Name
+			entry.Unit = field.Quantity.Units[system].Symbol
+		}
+		schema = append(schema, entry)
+	}
+	return schema
+}
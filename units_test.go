@@ -0,0 +1,137 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Every numeric field must have a unit in every system
+func TestBeamFieldSchemasCoverEveryField(t *testing.T) {
+	for _, column := range beamColumns {
+		schema, ok := beamFieldSchemas[column.Name]
+		if !ok {
+			t.Errorf("%s has no schema", column.Name)
+			continue
+		}
+		numeric := reflect.TypeOf(SteelBeam{}).Field(column.Index).Type.Kind() == reflect.Float64
+		if numeric != (schema.Quantity != nil) {
+			t.Errorf("%s: numeric %v but quantity %v", column.Name, numeric, schema.Quantity)
+			continue
+		}
+		for _, system := range unitSystems {
+			if schema.Quantity != nil && schema.Quantity.Units[system].Factor <= 0 {
+				t.Errorf("%s has no %s unit", column.Name, system)
+			}
+		}
+	}
+}
+
+// The Blue Book gives UB 406x178x74 a notch width N of 96 mm and a notch
+// depth n of 28 mm; the schema must label the columns holding them that way
+func TestBeamFieldSchemasDetailingDimensions(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	if beam.Notch != 96 || beam.DimensionsForDetailingN != 28 {
+		t.Fatalf("notch %g, dimensions_for_detailing_n %g; want 96 and 28", beam.Notch, beam.DimensionsForDetailingN)
+	}
+	for field, want := range map[string]string{
+		"notch":                      "Notch width N for detailing",
+		"dimensions_for_detailing_n": "Notch depth n for detailing",
+	} {
+		if got := beamFieldSchemas[field].Description; got != want {
+			t.Errorf("%s described as %q, want %q", field, got, want)
+		}
+	}
+}
+
+// Converting into each system and back must return the catalogue value to
+// the six significant figures conversion keeps
+func TestConvertBeamRoundTrip(t *testing.T) {
+	for _, beam := range testSeedBeams(t) {
+		original := reflect.ValueOf(beam)
+		for _, system := range unitSystems {
+			converted := reflect.ValueOf(ConvertBeam(beam, system))
+			for _, column := range beamColumns {
+				if original.Field(column.Index).Kind() != reflect.Float64 {
+					continue
+				}
+				want := original.Field(column.Index).Float()
+				back := converted.Field(column.Index).Float() / beamFieldUnit(column.Name, system).Factor
+				if math.Abs(back-want) > 1e-5*math.Abs(want) {
+					t.Fatalf("%s %s in %s: round trip gave %g, want %g", beam.SectionDesignation, column.Name, system, back, want)
+				}
+			}
+		}
+	}
+}
+
+// UB 406x178x74 converted by hand from the exact definitions 1 in = 25.4 mm,
+// 1 ft = 0.3048 m, 1 lb = 0.45359237 kg and 1 short ton = 2000 lb
+func TestConvertBeamKnownValues(t *testing.T) {
+	beam := catalogueBeam(t, "UB406x178x74")
+	tests := []struct {
+		system UnitSystem
+		field  string
+		want   float64
+	}{
+		{UnitsCatalogue, "depth_of_section", 412.8},
+		{UnitsSI, "depth_of_section", 0.4128},
+		{UnitsImperial, "depth_of_section", 16.252},
+		{UnitsSI, "radius_of_gyration_axis_y", 0.17},
+		{UnitsMillimetre, "radius_of_gyration_axis_y", 170},
+		{UnitsSI, "area_of_section", 9.45e-3},
+		{UnitsMillimetre, "area_of_section", 9450},
+		{UnitsImperial, "area_of_section", 14.6475},
+		{UnitsSI, "second_moment_of_area_axis_y", 2.73e-4},
+		{UnitsMillimetre, "second_moment_of_area_axis_y", 2.73e8},
+		{UnitsImperial, "second_moment_of_area_axis_y", 655.885},
+		{UnitsSI, "plastic_modulus_axis_y", 1.5e-3},
+		{UnitsMillimetre, "plastic_modulus_axis_y", 1.5e6},
+		{UnitsImperial, "plastic_modulus_axis_y", 91.5356},
+		{UnitsSI, "warping_constant", 6.08e-7},
+		{UnitsMillimetre, "warping_constant", 6.08e11},
+		{UnitsImperial, "warping_constant", 2264.13},
+		{UnitsSI, "mass_per_metre", 74.2},
+		{UnitsImperial, "mass_per_metre", 49.8601},
+		{UnitsImperial, "surface_area_per_metre", 4.95407},
+		{UnitsSI, "surface_area_per_tonne", 0.0203},
+		{UnitsImperial, "surface_area_per_tonne", 198.227},
+		{UnitsImperial, "buckling_parameter", 0.882},
+	}
+	for _, tt := range tests {
+		column, _ := beamColumnByName(tt.field)
+		got := beamFieldValue(ConvertBeam(beam, tt.system), column)
+		if math.Abs(got-tt.want) > 1e-5*math.Abs(tt.want) {
+			t.Errorf("%s in %s = %g, want %g", tt.field, tt.system, got, tt.want)
+		}
+	}
+}
+
+// Filter bounds given in a unit system select the same beams as the
+// equivalent catalogue bounds
+func TestBeamQueryInUnits(t *testing.T) {
+	maxDepth, minWpl := 0.42, 1.5e6
+	q := BeamQuery{Filters: []BeamRangeFilter{
+		{Field: "depth_of_section", Max: &maxDepth},
+	}}.InUnits(UnitsSI)
+	assertWithin(t, "max depth", *q.Filters[0].Max, 420, 1e-9)
+
+	q = BeamQuery{Filters: []BeamRangeFilter{
+		{Field: "plastic_modulus_axis_y", Min: &minWpl},
+	}}.InUnits(UnitsMillimetre)
+	assertWithin(t, "min Wpl,y", *q.Filters[0].Min, 1500, 1e-9)
+	if maxDepth != 0.42 || minWpl != 1.5e6 {
+		t.Error("InUnits modified the caller's bounds")
+	}
+}
+
+func TestParseUnitSystem(t *testing.T) {
+	for value, want := range map[string]UnitSystem{"": UnitsCatalogue, "si": UnitsSI, "mm": UnitsMillimetre, "imperial": UnitsImperial} {
+		if got, err := ParseUnitSystem(value); err != nil || got != want {
+			t.Errorf("ParseUnitSystem(%q) = %q, %v; want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseUnitSystem("SI"); err == nil {
+		t.Error("ParseUnitSystem accepted an unknown system")
+	}
+}
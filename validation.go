@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// sectionDesignationPattern matches designations such as UB406x178x74 or
+// UC152x152x23: a family prefix followed by two to four dimensions
+var sectionDesignationPattern = regexp.MustCompile(`^[A-Z]{1,4}\d+(\.\d+)?(x\d+(\.\d+)?){1,3}$`)
+
+// maxSectionDesignationLength bounds designations to keep them usable as URL segments
+const maxSectionDesignationLength = 64
+
+// requiredBeamFields must be positive on every beam; all other numeric
+// fields may be zero when unknown but never negative
+var requiredBeamFields = []string{
+	"mass_per_metre", "depth_of_section", "width_of_section",
+	"thickness_web", "thickness_flange", "area_of_section",
+}
+
+// FieldError is a problem with one field, named by its SteelBeam JSON tag
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError lists every problem found with a beam
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		problems[i] = fe.Field + ": " + fe.Message
+	}
+	return "invalid beam: " + strings.Join(problems, "; ")
+}
+
+func (e *ValidationError) add(field, format string, args ...any) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateBeam checks a beam before it is stored. It returns a
+// *ValidationError listing every problem, or nil if the beam is valid.
+// Uniqueness is enforced by the repository, which reports ErrBeamExists.
+func ValidateBeam(beam SteelBeam) error {
+	v := &ValidationError{}
+
+	switch designation := beam.SectionDesignation; {
+	case designation == "":
+		v.add("section_designation", "is required")
+	case len(designation) > maxSectionDesignationLength:
+		v.add("section_designation", "must be at most %d characters", maxSectionDesignationLength)
+	case !sectionDesignationPattern.MatchString(designation):
+		v.add("section_designation", "must be a family prefix followed by dimensions separated by x, e.g. UB406x178x74")
+	}
+
+	required := map[string]bool{}
+	for _, field := range requiredBeamFields {
+		required[field] = true
+	}
+	for _, column := range beamColumns {
+		if column.Name == "section_designation" {
+			continue
+		}
+		value := beamFieldValue(beam, column)
+		switch {
+		case math.IsNaN(value) || math.IsInf(value, 0):
+			v.add(column.Name, "must be a finite number")
+		case required[column.Name] && value <= 0:
+			v.add(column.Name, "is required and must be positive")
+		case value < 0:
+			v.add(column.Name, "must not be negative")
+		}
+	}
+	if len(v.Errors) > 0 {
+		// Cross-field checks assume the individual values are sane
+		return v
+	}
+
+	if 2*beam.ThicknessFlange >= beam.DepthOfSection {
+		v.add("thickness_flange", "twice the flange thickness must be less than depth_of_section")
+	}
+	if beam.ThicknessWeb >= beam.WidthOfSection {
+		v.add("thickness_web", "must be less than width_of_section")
+	}
+	if beam.DepthBetweenFillets > 0 && beam.DepthBetweenFillets > beam.DepthOfSection-2*beam.ThicknessFlange {
+		v.add("depth_between_fillets", "must not exceed depth_of_section less both flanges")
+	}
+	if beam.SecondMomentOfAreaAxisZ > 0 && beam.SecondMomentOfAreaAxisY < beam.SecondMomentOfAreaAxisZ {
+		v.add("second_moment_of_area_axis_y", "must not be less than second_moment_of_area_axis_z")
+	}
+	if beam.ElasticModulusAxisY > 0 && beam.PlasticModulusAxisY > 0 && beam.PlasticModulusAxisY < beam.ElasticModulusAxisY {
+		v.add("plastic_modulus_axis_y", "must not be less than elastic_modulus_axis_y")
+	}
+	if beam.ElasticModulusAxisZ > 0 && beam.PlasticModulusAxisZ > 0 && beam.PlasticModulusAxisZ < beam.ElasticModulusAxisZ {
+		v.add("plastic_modulus_axis_z", "must not be less than elastic_modulus_axis_z")
+	}
+	if beam.BucklingParameter > 1 {
+		v.add("buckling_parameter", "must not exceed 1")
+	}
+
+	if len(v.Errors) > 0 {
+		return v
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateBeam(t *testing.T) {
+	valid := catalogueBeam(t, "UB406x178x74")
+	const pattern = "must be a family prefix followed by dimensions separated by x, e.g. UB406x178x74"
+	tests := []struct {
+		name   string
+		change func(b *SteelBeam)
+		want   []FieldError
+	}{
+		{"catalogue beam", func(b *SteelBeam) {}, nil},
+		{"two dimensions", func(b *SteelBeam) { b.SectionDesignation = "SHS100x5" }, nil},
+		{"decimal dimension", func(b *SteelBeam) { b.SectionDesignation = "CHS48.3x3.2" }, nil},
+		{"no designation", func(b *SteelBeam) { b.SectionDesignation = "" },
+			[]FieldError{{"section_designation", "is required"}}},
+		{"long designation", func(b *SteelBeam) { b.SectionDesignation = "UB" + strings.Repeat("1", 63) },
+			[]FieldError{{"section_designation", "must be at most 64 characters"}}},
+		{"no family prefix", func(b *SteelBeam) { b.SectionDesignation = "406x178x74" },
+			[]FieldError{{"section_designation", pattern}}},
+		{"lower case", func(b *SteelBeam) { b.SectionDesignation = "ub406x178x74" },
+			[]FieldError{{"section_designation", pattern}}},
+		{"one dimension", func(b *SteelBeam) { b.SectionDesignation = "UB406" },
+			[]FieldError{{"section_designation", pattern}}},
+		{"five dimensions", func(b *SteelBeam) { b.SectionDesignation = "UB1x2x3x4x5" },
+			[]FieldError{{"section_designation", pattern}}},
+		{"path separator", func(b *SteelBeam) { b.SectionDesignation = "UB406/178x74" },
+			[]FieldError{{"section_designation", pattern}}},
+		{"zero required field", func(b *SteelBeam) { b.MassPerMetre = 0 },
+			[]FieldError{{"mass_per_metre", "is required and must be positive"}}},
+		{"negative required field", func(b *SteelBeam) { b.AreaOfSection = -1 },
+			[]FieldError{{"area_of_section", "is required and must be positive"}}},
+		{"zero optional field", func(b *SteelBeam) { b.RootRadius, b.WarpingConstant = 0, 0 }, nil},
+		{"negative optional field", func(b *SteelBeam) { b.RootRadius = -1 },
+			[]FieldError{{"root_radius", "must not be negative"}}},
+		{"not a number", func(b *SteelBeam) { b.TorsionalConstant = math.NaN() },
+			[]FieldError{{"torsional_constant", "must be a finite number"}}},
+		{"infinite", func(b *SteelBeam) { b.DepthOfSection = math.Inf(1) },
+			[]FieldError{{"depth_of_section", "must be a finite number"}}},
+		{"every field problem is listed", func(b *SteelBeam) { b.SectionDesignation, b.ThicknessWeb, b.RootRadius = "", 0, -1 },
+			[]FieldError{
+				{"section_designation", "is required"},
+				{"thickness_web", "is required and must be positive"},
+				{"root_radius", "must not be negative"},
+			}},
+		{"flanges fill the depth", func(b *SteelBeam) { b.ThicknessFlange = b.DepthOfSection / 2 },
+			[]FieldError{
+				{"thickness_flange", "twice the flange thickness must be less than depth_of_section"},
+				{"depth_between_fillets", "must not exceed depth_of_section less both flanges"},
+			}},
+		{"web as wide as the section", func(b *SteelBeam) { b.ThicknessWeb = b.WidthOfSection },
+			[]FieldError{{"thickness_web", "must be less than width_of_section"}}},
+		{"fillets deeper than the web", func(b *SteelBeam) { b.DepthBetweenFillets = b.DepthOfSection - 2*b.ThicknessFlange + 1 },
+			[]FieldError{{"depth_between_fillets", "must not exceed depth_of_section less both flanges"}}},
+		{"minor axis stiffer", func(b *SteelBeam) { b.SecondMomentOfAreaAxisZ = b.SecondMomentOfAreaAxisY + 1 },
+			[]FieldError{{"second_moment_of_area_axis_y", "must not be less than second_moment_of_area_axis_z"}}},
+		{"plastic below elastic about y", func(b *SteelBeam) { b.PlasticModulusAxisY = b.ElasticModulusAxisY - 1 },
+			[]FieldError{{"plastic_modulus_axis_y", "must not be less than elastic_modulus_axis_y"}}},
+		{"plastic below elastic about z", func(b *SteelBeam) { b.PlasticModulusAxisZ = b.ElasticModulusAxisZ - 1 },
+			[]FieldError{{"plastic_modulus_axis_z", "must not be less than elastic_modulus_axis_z"}}},
+		{"buckling parameter above 1", func(b *SteelBeam) { b.BucklingParameter = 1.01 },
+			[]FieldError{{"buckling_parameter", "must not exceed 1"}}},
+		// Cross-field checks only run once every value is sane
+		{"cross-field checks wait for field errors", func(b *SteelBeam) { b.ThicknessWeb, b.RootRadius = b.WidthOfSection, -1 },
+			[]FieldError{{"root_radius", "must not be negative"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beam := valid
+			tt.change(&beam)
+			err := ValidateBeam(beam)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var invalid *ValidationError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("got %v, want a *ValidationError", err)
+			}
+			if !reflect.DeepEqual(invalid.Errors, tt.want) {
+				t.Errorf("errors:\n got %v\nwant %v", invalid.Errors, tt.want)
+			}
+		})
+	}
+}
+
+// A PUT body naming a different beam is rejected rather than renaming it
+func TestUpdateBeamRejectsDifferentDesignation(t *testing.T) {
+	silenceLog(t)
+	beam := catalogueBeam(t, "UB406x178x74")
+	repo := NewMemoryBeamRepository([]SteelBeam{beam})
+	events := NewBeamEventLog(defaultBeamEventLogSize)
+	app := newTestHTTPApp(repo, NewBeamService(repo, events), events)
+
+	renamed := beam
+	renamed.SectionDesignation = "UB406x178x75"
+	body, err := json.Marshal(renamed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPut, "/beams/UB406x178x74", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var got struct {
+		Error  string       `json:"error"`
+		Errors []FieldError `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := []FieldError{{"section_designation", "must match the designation being updated (UB406x178x74)"}}
+	if resp.StatusCode != http.StatusBadRequest || got.Error != "Validation failed" || !reflect.DeepEqual(got.Errors, want) {
+		t.Errorf("got %d %q %v, want 400 with %v", resp.StatusCode, got.Error, got.Errors, want)
+	}
+
+	beams, err := repo.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := beamDesignations(beams); !reflect.DeepEqual(got, []string{"UB406x178x74"}) {
+		t.Errorf("catalogue %v after a rejected rename", got)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// xlsxParts are the fixed parts of a single-sheet Office Open XML workbook.
+// Style 1 is bold, used for the header row.
+var xlsxParts = []struct{ name, body string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`},
+}
+
+// writeXLSX writes a workbook with one sheet holding a bold, frozen header
+// row followed by rows. Cells may be strings or float64s; floats are stored
+// as numbers so spreadsheets can calculate with them.
+func writeXLSX(w io.Writer, sheetName string, header []string, rows [][]any) error {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.body); err != nil {
+			return err
+		}
+	}
+
+	f, err := archive.Create("xl/workbook.xml")
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(f, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`)
+	xml.EscapeText(f, []byte(sheetName))
+	fmt.Fprint(f, `" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+
+	f, err = archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	sheet := bufio.NewWriter(f)
+	fmt.Fprint(sheet, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`+
+		`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`+
+		`<sheetData>`)
+	headerCells := make([]any, len(header))
+	for i, name := range header {
+		headerCells[i] = name
+	}
+	writeXLSXRow(sheet, 1, headerCells, 1)
+	for i, row := range rows {
+		writeXLSXRow(sheet, i+2, row, 0)
+	}
+	fmt.Fprint(sheet, `</sheetData></worksheet>`)
+	if err := sheet.Flush(); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// writeXLSXRow writes one <row> element with the given cell style
+func writeXLSXRow(w *bufio.Writer, number int, cells []any, style int) {
+	fmt.Fprintf(w, `<row r="%d">`, number)
+	for i, cell := range cells {
+		ref := xlsxColumnName(i) + strconv.Itoa(number)
+		styleAttr := ""
+		if style != 0 {
+			styleAttr = fmt.Sprintf(` s="%d"`, style)
+		}
+		switch value := cell.(type) {
+		case float64:
+			fmt.Fprintf(w, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, strconv.FormatFloat(value, 'g', -1, 64))
+		default:
+			fmt.Fprintf(w, `<c r="%s"%s t="inlineStr"><is><t>`, ref, styleAttr)
+			xml.EscapeText(w, []byte(fmt.Sprint(value)))
+			fmt.Fprint(w, `</t></is></c>`)
+		}
+	}
+	fmt.Fprint(w, `</row>`)
+}
+
+// xlsxColumnName returns the spreadsheet letters of a zero-based column: A, B, ..., Z, AA, ...
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}